@@ -0,0 +1,395 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aep-dev/aepc/loader"
+)
+
+const widgets = `name: widgets.example.com
+server_url: https://widgets.example.com
+resources:
+  widget:
+    singular: widget
+    plural: widgets
+    schema:
+      type: object
+      properties:
+        title:
+          type: string
+          x-aep-field:
+            field_number: 1
+        size:
+          type: integer
+          format: int32
+          x-aep-field:
+            field_number: 2
+    methods:
+      get: {}
+`
+
+// run executes aepc with args, returning what it wrote to
+// stdout and stderr.
+func run(t *testing.T, args ...string) (string, string, error) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	c := NewCommand()
+	c.SetArgs(args)
+	c.SetOut(&stdout)
+	c.SetErr(&stderr)
+	err := c.Execute()
+	return stdout.String(), stderr.String(), err
+}
+
+// writeFiles writes each of files, keyed by name, to a
+// temporary directory, and returns the directory.
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestGenerate(t *testing.T) {
+	t.Chdir(writeFiles(t, map[string]string{"widgets.yaml": widgets}))
+	_, _, err := run(t, "-i", "widgets.yaml", "-o", "widgets", "--target", "proto,openapi-json", "-q")
+	if err != nil {
+		t.Fatalf("aepc -i -o = %v", err)
+	}
+	for _, file := range []string{"widgets.proto", "widgets_openapi.json", "aepc.lock"} {
+		if _, err := os.Stat(file); err != nil {
+			t.Errorf("aepc -i -o did not write %s: %v", file, err)
+		}
+	}
+	if _, err := os.Stat("widgets_openapi.yaml"); err == nil {
+		t.Errorf("aepc --target wrote an unselected target")
+	}
+	_, _, err = run(t, "generate", "-i", "widgets.yaml", "-o", "widgets", "--target", "cobol")
+	if err == nil || !strings.Contains(err.Error(), "cobol") {
+		t.Errorf("aepc generate --target cobol = %v, want an unknown target", err)
+	}
+	if _, _, err := run(t, "generate", "-o", "widgets"); err == nil || !strings.Contains(err.Error(), `"input"`) {
+		t.Errorf("aepc generate without -i = %v, want a missing input", err)
+	}
+}
+
+func TestGenerateVersions(t *testing.T) {
+	v1 := "version: v1\n" + widgets
+	// v2 renames title, reusing its field number.
+	v2 := "version: v2\n" + strings.Replace(widgets, "title:", "label:", 1)
+	t.Chdir(writeFiles(t, map[string]string{
+		"v1/widgets.yaml":      v1,
+		"v2/widgets.yaml":      v2,
+		"v2beta/widgets.yaml":  "version: v2\n" + widgets,
+		"shared/widgets.yaml":  v1,
+		"shared/widgets2.yaml": v2,
+	}))
+
+	_, _, err := run(t, "generate", "-i", "v1/widgets.yaml", "-i", "v2/widgets.yaml", "--out-dir", "out", "--target", "proto", "-q")
+	if err != nil {
+		t.Fatalf("aepc generate -i -i --out-dir = %v", err)
+	}
+	for _, file := range []string{
+		"out/com/example/widgets/v1/widgets.proto",
+		"out/com/example/widgets/v2/widgets.proto",
+		"v1/aepc.lock",
+		"v2/aepc.lock",
+	} {
+		if _, err := os.Stat(file); err != nil {
+			t.Errorf("aepc generate -i -i --out-dir did not write %s: %v", file, err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "same directory",
+			args:    []string{"-i", "v2/widgets.yaml", "-i", "v2beta/widgets.yaml", "--out-dir", "collision"},
+			wantErr: "are both generated to com/example/widgets/v2",
+		},
+		{
+			name:    "shared lockfile",
+			args:    []string{"-i", "shared/widgets.yaml", "-i", "shared/widgets2.yaml", "--out-dir", "collision"},
+			wantErr: "share the lockfile shared/aepc.lock",
+		},
+		{
+			name:    "output prefix",
+			args:    []string{"-i", "v1/widgets.yaml", "-i", "v2/widgets.yaml", "-o", "collision"},
+			wantErr: "several inputs can only be generated with an output directory",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := run(t, append([]string{"generate", "-q"}, tt.args...)...)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("aepc generate %v = %v, want %q", tt.args, err, tt.wantErr)
+			}
+			// nothing is generated.
+			if _, err := os.Stat("collision"); !os.IsNotExist(err) {
+				t.Errorf("aepc generate %v wrote collision: %v", tt.args, err)
+			}
+		})
+	}
+
+	// without a lockfile, versions in one directory are generated.
+	_, _, err = run(t, "generate", "-i", "shared/widgets.yaml", "-i", "shared/widgets2.yaml", "--out-dir", "shared-out", "--no-lockfile", "-q")
+	if err != nil {
+		t.Errorf("aepc generate --no-lockfile = %v", err)
+	}
+}
+
+func TestGenerateStdio(t *testing.T) {
+	t.Chdir(t.TempDir())
+	defer func(r io.Reader) { stdin = r }(stdin)
+	stdin = strings.NewReader(widgets)
+	stdout, _, err := run(t, "generate", "-i", "-", "-o", "-", "--target", "proto", "-q")
+	if err != nil {
+		t.Fatalf("aepc generate -i - -o - = %v", err)
+	}
+	if !strings.Contains(stdout, "message Widget {") {
+		t.Errorf("aepc generate -o - wrote %q, want the proto", stdout)
+	}
+	entries, err := os.ReadDir(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) > 0 {
+		t.Errorf("aepc generate -o - wrote %v, want no files", entries)
+	}
+	if _, _, err := run(t, "generate", "-i", "-", "-o", "-"); err == nil || !strings.Contains(err.Error(), "single target") {
+		t.Errorf("aepc generate -o - of several targets = %v, want an error", err)
+	}
+}
+
+func TestGenerateStreamKeepsLockfile(t *testing.T) {
+	t.Chdir(writeFiles(t, map[string]string{"widgets.yaml": widgets}))
+	if _, _, err := run(t, "generate", "-i", "widgets.yaml", "-o", "-", "--target", "proto", "-q"); err != nil {
+		t.Fatalf("aepc generate -o - = %v", err)
+	}
+	if _, err := os.Stat("aepc.lock"); !os.IsNotExist(err) {
+		t.Errorf("aepc generate -o - wrote aepc.lock: %v", err)
+	}
+}
+
+func TestGenerateUnresolvedParent(t *testing.T) {
+	orphan := strings.Replace(widgets, "    plural: widgets\n", "    plural: widgets\n    parents: [library]\n", 1)
+	dir := writeFiles(t, map[string]string{"widgets.yaml": orphan})
+	input := filepath.Join(dir, "widgets.yaml")
+	_, _, err := run(t, "-i", input, "-o", filepath.Join(dir, "widgets"))
+	if err == nil || !strings.Contains(err.Error(), `parent "library" is not a declared resource`) {
+		t.Errorf("generate with an undeclared parent = %v, want the parent reported", err)
+	}
+	config := "rules:\n  resource-parent-unresolved:\n    disabled: true\n"
+	if err := os.WriteFile(filepath.Join(dir, "aepc.yaml"), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = run(t, "-i", input, "-o", filepath.Join(dir, "widgets"))
+	if err == nil || !strings.Contains(err.Error(), "cannot be configured") {
+		t.Errorf("generate with resource-parent-unresolved disabled = %v, want the configuration rejected", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"widgets.yaml": widgets,
+		"invalid.yaml": strings.Replace(widgets, "plural: widgets", "plural: widget", 1),
+	})
+	valid, invalid := filepath.Join(dir, "widgets.yaml"), filepath.Join(dir, "invalid.yaml")
+
+	if _, _, err := run(t, "validate", "-i", valid); err != nil {
+		t.Errorf("validate of a valid definition = %v", err)
+	}
+	stdout, _, err := run(t, "validate", "-i", invalid)
+	if err == nil || !strings.Contains(err.Error(), "validation failed") {
+		t.Errorf("validate of an invalid definition = %v, want validation failed", err)
+	}
+	if !strings.Contains(stdout, "invalid.yaml:") {
+		t.Errorf("validate printed %q, want diagnostics located in the input", stdout)
+	}
+	for _, format := range []string{"json", "sarif"} {
+		stdout, _, err := run(t, "validate", "-i", invalid, "--format", format)
+		if err == nil {
+			t.Errorf("validate --format %s of an invalid definition succeeded", format)
+		}
+		if !json.Valid([]byte(stdout)) {
+			t.Errorf("validate --format %s printed invalid JSON: %s", format, stdout)
+		}
+	}
+	malformed := filepath.Join(writeFiles(t, map[string]string{"malformed.yaml": "name: [\n"}), "malformed.yaml")
+	for _, format := range []string{"json", "sarif"} {
+		stdout, _, err := run(t, "validate", "-i", malformed, "--format", format)
+		if err == nil || !strings.Contains(err.Error(), "validation failed") {
+			t.Errorf("validate --format %s of a malformed definition = %v, want validation failed", format, err)
+		}
+		if !json.Valid([]byte(stdout)) || !strings.Contains(stdout, "load-syntax") {
+			t.Errorf("validate --format %s of a malformed definition printed %s, want a load-syntax diagnostic", format, stdout)
+		}
+	}
+	if _, _, err := run(t, "validate", "-i", valid, "--format", "xml"); err == nil {
+		t.Errorf("validate --format xml succeeded")
+	}
+	if _, _, err := run(t, "validate"); err == nil || !strings.Contains(err.Error(), `"input"`) {
+		t.Errorf("validate without -i = %v, want a missing input", err)
+	}
+	stdout, _, err = run(t, "validate", "--list-rules")
+	if err != nil || !strings.Contains(stdout, "resource-plural-distinct") {
+		t.Errorf("validate --list-rules = %q, %v, want the rules", stdout, err)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"old.yaml":          widgets,
+		"added.yaml":        strings.Replace(widgets, "      get: {}", "      get: {}\n      list: {}", 1),
+		"breaking.yaml":     strings.Replace(widgets, "type: integer\n          format: int32", "type: string", 1),
+		"enum.yaml":         strings.Replace(widgets, "type: string\n", "type: string\n          enum: [RED, BLUE]\n", 1),
+		"enum-removed.yaml": strings.Replace(widgets, "type: string\n", "type: string\n          enum: [RED]\n", 1),
+	})
+	old := filepath.Join(dir, "old.yaml")
+
+	stdout, _, err := run(t, "diff", old, old)
+	if err != nil || stdout != "" {
+		t.Errorf("diff of identical definitions = %q, %v, want no changes", stdout, err)
+	}
+	stdout, _, err = run(t, "diff", old, filepath.Join(dir, "added.yaml"))
+	if err != nil || !strings.Contains(stdout, "[method-added]") || strings.Contains(stdout, "(breaking)") {
+		t.Errorf("diff adding a method = %q, %v, want a non-breaking change", stdout, err)
+	}
+	breaking := filepath.Join(dir, "breaking.yaml")
+	stdout, _, err = run(t, "diff", old, breaking)
+	if err == nil || !strings.Contains(err.Error(), "--allow-breaking") {
+		t.Errorf("diff changing a type = %v, want breaking changes", err)
+	}
+	if !strings.Contains(stdout, "(breaking)") {
+		t.Errorf("diff changing a type printed %q, want a breaking change", stdout)
+	}
+	if _, _, err := run(t, "diff", old, breaking, "--allow-breaking"); err != nil {
+		t.Errorf("diff --allow-breaking = %v", err)
+	}
+	stdout, _, err = run(t, "diff", old, breaking, "--format", "json", "--allow-breaking")
+	if err != nil {
+		t.Fatalf("diff --format json = %v", err)
+	}
+	var got struct {
+		Breaking bool
+		Changes  []json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(stdout), &got); err != nil || !got.Breaking || len(got.Changes) == 0 {
+		t.Errorf("diff --format json = %s, %v, want breaking changes", stdout, err)
+	}
+	stdout, _, err = run(t, "diff", filepath.Join(dir, "enum.yaml"), filepath.Join(dir, "enum-removed.yaml"))
+	if err == nil || !strings.Contains(stdout, "[enum-value-removed] (breaking)") {
+		t.Errorf("diff removing an enum value = %q, %v, want a breaking change", stdout, err)
+	}
+	if _, _, err := run(t, "diff", old); err == nil {
+		t.Errorf("diff of a single definition succeeded")
+	}
+}
+
+func TestFieldNumbersFromLockfile(t *testing.T) {
+	numbered := strings.Replace(widgets, "field_number: 1", "field_number: 3", 1)
+	dir := writeFiles(t, map[string]string{
+		"old.yaml":  numbered,
+		"new.yaml":  strings.Replace(numbered, "          x-aep-field:\n            field_number: 3\n", "", 1),
+		"aepc.lock": "messages:\n  Widget:\n    fields:\n      title: 3\n",
+	})
+	newFile := filepath.Join(dir, "new.yaml")
+	stdout, _, err := run(t, "diff", filepath.Join(dir, "old.yaml"), newFile)
+	if err != nil || stdout != "" {
+		t.Errorf("diff omitting a locked field number = %q, %v, want no changes", stdout, err)
+	}
+	if _, _, err := run(t, "validate", "-i", newFile); err != nil {
+		t.Errorf("validate omitting a locked field number = %v", err)
+	}
+	conflicting := filepath.Join(dir, "conflicting.lock")
+	if err := os.WriteFile(conflicting, []byte("messages:\n  Widget:\n    fields:\n      title: 2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	stdout, _, err = run(t, "validate", "-i", newFile, "--lockfile", conflicting)
+	if err == nil || !strings.Contains(stdout, "field-number-duplicate") {
+		t.Errorf("validate --lockfile of a conflicting lock = %q, %v, want field-number-duplicate", stdout, err)
+	}
+}
+
+func TestFmt(t *testing.T) {
+	unformatted := strings.Replace(widgets, "name: widgets.example.com\nserver_url: https://widgets.example.com\n",
+		"server_url: 'https://widgets.example.com'\nname: widgets.example.com\n", 1)
+	dir := writeFiles(t, map[string]string{"widgets.yaml": widgets, "unformatted.yaml": unformatted})
+	formattedFile, unformattedFile := filepath.Join(dir, "widgets.yaml"), filepath.Join(dir, "unformatted.yaml")
+
+	stdout, _, err := run(t, "fmt", unformattedFile)
+	if err != nil || stdout != widgets {
+		t.Errorf("fmt = %q, %v, want %q", stdout, err, widgets)
+	}
+	if _, _, err := run(t, "fmt", "--check", formattedFile); err != nil {
+		t.Errorf("fmt --check of a formatted file = %v", err)
+	}
+	if _, _, err := run(t, "fmt", "--check", unformattedFile); err == nil || !strings.Contains(err.Error(), "not formatted") {
+		t.Errorf("fmt --check of an unformatted file = %v, want not formatted", err)
+	}
+	if _, _, err := run(t, "fmt", "-w", "--check", unformattedFile); err == nil {
+		t.Errorf("fmt -w --check succeeded")
+	}
+	stdout, _, err = run(t, "fmt", "-w", unformattedFile)
+	if err != nil || stdout != "" {
+		t.Errorf("fmt -w = %q, %v, want no output", stdout, err)
+	}
+	if b, _ := os.ReadFile(unformattedFile); string(b) != widgets {
+		t.Errorf("fmt -w wrote %q, want %q", b, widgets)
+	}
+}
+
+func TestFmtWriteFieldNumbers(t *testing.T) {
+	input := strings.Replace(widgets, "          x-aep-field:\n            field_number: 2\n", "", 1)
+	dir := writeFiles(t, map[string]string{"widgets.yaml": input})
+	stdout, _, err := run(t, "fmt", "--write-field-numbers", filepath.Join(dir, "widgets.yaml"))
+	if err != nil || stdout != widgets {
+		t.Errorf("fmt --write-field-numbers = %q, %v, want %q", stdout, err, widgets)
+	}
+}
+
+func TestImport(t *testing.T) {
+	for _, tt := range []struct{ kind, input string }{
+		{"openapi", "../example/bookstore/v1/bookstore_openapi.json"},
+		{"proto", "../example/bookstore/v1/bookstore.proto"},
+	} {
+		t.Run(tt.kind, func(t *testing.T) {
+			stdout, _, err := run(t, "import", tt.kind, tt.input)
+			if err != nil {
+				t.Fatalf("import %s = %v", tt.kind, err)
+			}
+			if _, err := loader.Load("bookstore.yaml", []byte(stdout), loader.Options{Strict: true}); err != nil {
+				t.Errorf("import %s printed an invalid definition: %v\n%s", tt.kind, err, stdout)
+			}
+			output := filepath.Join(t.TempDir(), "bookstore.json")
+			stdout, _, err = run(t, "import", tt.kind, tt.input, "-o", output)
+			if err != nil || stdout != "" {
+				t.Fatalf("import %s -o = %q, %v, want no output", tt.kind, stdout, err)
+			}
+			b, err := os.ReadFile(output)
+			if err != nil || !json.Valid(b) {
+				t.Errorf("import %s -o %s wrote %s, %v, want JSON", tt.kind, output, b, err)
+			}
+		})
+	}
+	if _, _, err := run(t, "import", "openapi", "does-not-exist.json"); err == nil {
+		t.Errorf("import of a missing file succeeded")
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2023 Yusuke Fredrick Tsutsumi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/aep-dev/aepc/diff"
+	"github.com/spf13/cobra"
+)
+
+func newDiffCommand() *cobra.Command {
+	var allowBreaking bool
+	var format string
+
+	c := &cobra.Command{
+		Use:   "diff <old> <new>",
+		Short: "show the changes between two resource definitions, failing on breaking changes",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// each definition is numbered from the lockfile
+			// next to it.
+			oldDef, err := loadNumberedDefinition(args[0], false, "")
+			if err != nil {
+				return fmt.Errorf("error loading %s: %w", args[0], err)
+			}
+			newDef, err := loadNumberedDefinition(args[1], false, "")
+			if err != nil {
+				return fmt.Errorf("error loading %s: %w", args[1], err)
+			}
+			changes := diff.Compare(oldDef, newDef)
+			if err := writeChanges(cmd.OutOrStdout(), format, changes); err != nil {
+				return err
+			}
+			if diff.HasBreaking(changes) && !allowBreaking {
+				return fmt.Errorf("%s has breaking changes from %s, pass --allow-breaking to accept them", args[1], args[0])
+			}
+			return nil
+		},
+	}
+	c.Flags().BoolVar(&allowBreaking, "allow-breaking", false, "exit successfully even if there are breaking changes")
+	c.Flags().StringVar(&format, "format", "text", "output format of the changes, one of [text json]")
+	return c
+}
+
+// writeChanges writes changes to w, as a line per change or
+// as JSON.
+func writeChanges(w io.Writer, format string, changes []diff.Change) error {
+	switch format {
+	case "text", "":
+		for _, c := range changes {
+			if _, err := fmt.Fprintln(w, c); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(struct {
+			Breaking bool          `json:"breaking"`
+			Changes  []diff.Change `json:"changes"`
+		}{Breaking: diff.HasBreaking(changes), Changes: changes})
+	default:
+		return fmt.Errorf("unsupported format %q, must be one of [text json]", format)
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2023 Yusuke Fredrick Tsutsumi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/aep-dev/aepc/format"
+	"github.com/aep-dev/aepc/generator"
+	"github.com/aep-dev/aepc/loader"
+	"github.com/aep-dev/aepc/lock"
+	"github.com/spf13/cobra"
+)
+
+func newFmtCommand() *cobra.Command {
+	var write bool
+	var check bool
+	var writeFieldNumbers bool
+	var lockfile string
+
+	c := &cobra.Command{
+		Use:   "fmt <file>",
+		Short: "canonicalize the formatting of a resource definition",
+		Long: "canonicalize the formatting of a resource definition: keys in a fixed order, " +
+			"resources and schemas sorted by name, properties sorted by field number, " +
+			"and consistent quoting and indentation. Comments are preserved.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if write && check {
+				return fmt.Errorf("--write and --check are mutually exclusive")
+			}
+			inputFile := args[0]
+			if inputFile == stdio && write {
+				return fmt.Errorf("stdin can only be formatted to stdout")
+			}
+			input, err := readInput(inputFile)
+			if err != nil {
+				return fmt.Errorf("unable to read file: %w", err)
+			}
+			// reject anything the loader would not accept.
+			d, err := parseDefinition(inputFile, input, loader.Options{})
+			if err != nil {
+				return err
+			}
+			assigned := map[string]map[string]int{}
+			if writeFieldNumbers {
+				l, err := lock.Read(lockPath(inputFile, lockfile))
+				if err != nil {
+					return fmt.Errorf("unable to read lockfile: %w", err)
+				}
+				assigned, err = assignmentsByFile(inputFile, d, generator.AssignFieldNumbers(d, l))
+				if err != nil {
+					return err
+				}
+			}
+			for _, file := range sortedKeys(assigned) {
+				if file == filepath.Clean(inputFile) {
+					continue
+				}
+				// imported files are only ever rewritten in place.
+				if !write {
+					return fmt.Errorf("%s: writing field numbers to an imported file requires -w", file)
+				}
+				b, err := ReadFile(file)
+				if err != nil {
+					return fmt.Errorf("unable to read file: %w", err)
+				}
+				formatted, err := format.Definition(loader.Format(file, b), b, format.Options{FieldNumbers: assigned[file]})
+				if err != nil {
+					return fmt.Errorf("%s: %w", file, err)
+				}
+				if err := WriteFile(file, formatted); err != nil {
+					return err
+				}
+			}
+			formatted, err := format.Definition(loader.Format(inputFile, input), input, format.Options{
+				FieldNumbers: assigned[filepath.Clean(inputFile)],
+			})
+			if err != nil {
+				return fmt.Errorf("%s: %w", inputFile, err)
+			}
+			switch {
+			case check:
+				if !bytes.Equal(input, formatted) {
+					return fmt.Errorf("%s is not formatted, run aepc fmt -w %s", inputFile, inputFile)
+				}
+				return nil
+			case !write:
+				_, err = cmd.OutOrStdout().Write(formatted)
+				return err
+			case bytes.Equal(input, formatted):
+				return nil
+			}
+			return WriteFile(inputFile, formatted)
+		},
+	}
+	c.Flags().BoolVarP(&write, "write", "w", false, "write the result to the file instead of stdout")
+	c.Flags().BoolVar(&check, "check", false, "exit non-zero if the file is not formatted, without writing it")
+	c.Flags().BoolVar(&writeFieldNumbers, "write-field-numbers", false, "write the numbers assigned to fields that omit them")
+	c.Flags().StringVar(&lockfile, "lockfile", "", fmt.Sprintf("lockfile the field numbers are assigned from, defaults to %s next to the input", lock.File))
+	return c
+}
+
+// assignmentsByFile groups assigned field numbers by the file
+// declaring their property, keyed by the property's pointer.
+func assignmentsByFile(inputFile string, d *loader.Definition, assigned []generator.Assignment) (map[string]map[string]int, error) {
+	files := map[string]map[string]int{}
+	for _, a := range assigned {
+		pos, ok := d.Source.Lookup(a.Pointer)
+		if !ok {
+			return nil, fmt.Errorf("%s: unable to locate %s", inputFile, a.Pointer)
+		}
+		file := filepath.Clean(pos.File)
+		if files[file] == nil {
+			files[file] = map[string]int{}
+		}
+		files[file][a.Pointer] = a.Number
+	}
+	return files, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
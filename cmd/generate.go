@@ -0,0 +1,40 @@
+// Copyright 2023 Yusuke Fredrick Tsutsumi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func newGenerateCommand() *cobra.Command {
+	var inputFiles []string
+	var outputFilePrefix string
+	var opts Options
+
+	c := &cobra.Command{
+		Use:   "generate",
+		Short: "generate proto, openapi and other targets from a resource definition",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Output = cmd.OutOrStdout()
+			return generate(inputFiles, outputFilePrefix, opts)
+		},
+	}
+	c.Flags().StringArrayVarP(&inputFiles, "input", "i", nil, "input files with resource, repeated to generate several into --out-dir")
+	c.Flags().StringVarP(&outputFilePrefix, "output", "o", "", "output file to write to. File types will be appended to this prefix)")
+	addOptionFlags(c, &opts)
+	c.MarkFlagRequired("input")
+	c.MarkFlagsMutuallyExclusive("output", "out-dir")
+	return c
+}
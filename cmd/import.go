@@ -0,0 +1,117 @@
+// Copyright 2023 Yusuke Fredrick Tsutsumi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/aep-dev/aepc/importer"
+	"github.com/spf13/cobra"
+)
+
+func newImportCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "import",
+		Short: "convert an existing API description into a resource definition",
+	}
+	c.AddCommand(newImportOpenAPICommand(), newImportProtoCommand())
+	return c
+}
+
+func newImportOpenAPICommand() *cobra.Command {
+	var outputFile string
+	var opts importer.OpenAPIOptions
+
+	c := &cobra.Command{
+		Use:   "openapi <spec>",
+		Short: "convert an OpenAPI 3 spec into a resource definition",
+		Long: "convert an OpenAPI 3 spec, in JSON or YAML, into a resource definition. " +
+			"Resources are inferred from the paths and the schemas they respond with, " +
+			"or from their x-aep-resource annotations. Endpoints that the definition " +
+			"does not generate are listed on stderr.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spec, err := ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("unable to read file: %w", err)
+			}
+			r, err := importer.OpenAPI(spec, opts)
+			if err != nil {
+				return fmt.Errorf("unable to import %s: %w", args[0], err)
+			}
+			return writeImport(cmd, r, outputFile)
+		},
+	}
+	c.Flags().StringVarP(&outputFile, "output", "o", "", "file to write the definition to, as JSON if it ends in .json, defaults to YAML on stdout")
+	c.Flags().StringVar(&opts.ServerURL, "server-url", "", "server URL of the API, defaults to the first server of the spec")
+	c.Flags().StringVar(&opts.PathPrefix, "path-prefix", "", "prefix of the paths of the API, e.g. /v1, stripped from each path")
+	return c
+}
+
+func newImportProtoCommand() *cobra.Command {
+	var outputFile string
+	var opts importer.ProtoOptions
+
+	c := &cobra.Command{
+		Use:   "proto <file>",
+		Short: "convert an annotated proto file into a resource definition",
+		Long: "convert a proto file into a resource definition. Resources are the messages " +
+			"with an aep.api.resource option, and their methods are inferred from the " +
+			"google.api.http rules of the RPCs. Imports are resolved from the import paths, " +
+			"then from the aep, google.api and well-known protos built into aepc, without " +
+			"network access. RPCs that do not map to a method, and fields whose type " +
+			"is not reproduced by generation, are listed on stderr.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			r, err := importer.Proto(args[0], opts)
+			if err != nil {
+				return fmt.Errorf("unable to import %s: %w", args[0], err)
+			}
+			return writeImport(cmd, r, outputFile)
+		},
+	}
+	c.Flags().StringVarP(&outputFile, "output", "o", "", "file to write the definition to, as JSON if it ends in .json, defaults to YAML on stdout")
+	c.Flags().StringArrayVarP(&opts.ImportPaths, "proto_path", "I", nil, "directory to resolve imports from, may be repeated")
+	c.Flags().StringVar(&opts.ServerURL, "server-url", "", "server URL of the API, which protos do not declare")
+	return c
+}
+
+// writeImport writes the definition imported as r to outputFile,
+// or stdout, and lists the unmapped endpoints and the warnings
+// of the import on stderr.
+func writeImport(cmd *cobra.Command, r *importer.Result, outputFile string) error {
+	ext := ".yaml"
+	if filepath.Ext(outputFile) == ".json" {
+		ext = ".json"
+	}
+	b, err := r.Definition(ext)
+	if err != nil {
+		return err
+	}
+	if outputFile == "" {
+		if _, err := cmd.OutOrStdout().Write(b); err != nil {
+			return err
+		}
+	} else if err := WriteFile(outputFile, b); err != nil {
+		return err
+	}
+	for _, e := range r.Unmapped {
+		fmt.Fprintf(cmd.ErrOrStderr(), "unmapped: %s\n", e)
+	}
+	for _, w := range r.Warnings {
+		fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s\n", w)
+	}
+	return nil
+}
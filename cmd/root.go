@@ -16,108 +16,487 @@ package cmd
 import (
 	"fmt"
 	"io"
-	"log"
 	"os"
 	"path/filepath"
+	"sort"
 
-	"github.com/ghodss/yaml"
-
-	"github.com/aep-dev/aep-lib-go/pkg/api"
-	"github.com/aep-dev/aep-lib-go/pkg/proto"
+	"github.com/aep-dev/aepc/generator"
+	"github.com/aep-dev/aepc/loader"
+	"github.com/aep-dev/aepc/lock"
 	"github.com/aep-dev/aepc/validator"
 	"github.com/spf13/cobra"
 )
 
 func NewCommand() *cobra.Command {
-	var inputFile string
+	var inputFiles []string
 	var outputFilePrefix string
+	var opts Options
 
 	c := &cobra.Command{
 		Use:   "aepc",
 		Short: "aepc compiles resource representations to full proto rpcs",
 		Long:  "aepc compiles resource representations to full proto rpcs",
-		Run: func(cmd *cobra.Command, args []string) {
-			err := ProcessInput(inputFile, outputFilePrefix)
-			if err != nil {
-				log.Fatal(err)
+		// invoking aepc with -i and -o and no subcommand is
+		// equivalent to "aepc generate".
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(inputFiles) == 0 && outputFilePrefix == "" && opts.OutDir == "" {
+				return cmd.Help()
 			}
+			opts.Output = cmd.OutOrStdout()
+			return generate(inputFiles, outputFilePrefix, opts)
 		},
+		SilenceUsage:  true,
+		SilenceErrors: true,
 	}
-	c.Flags().StringVarP(&inputFile, "input", "i", "", "input files with resource")
+	c.Flags().StringArrayVarP(&inputFiles, "input", "i", nil, "input files with resource, repeated to generate several into --out-dir")
 	c.Flags().StringVarP(&outputFilePrefix, "output", "o", "", "output file to write to. File types will be appended to this prefix)")
+	addOptionFlags(c, &opts)
+	c.MarkFlagsMutuallyExclusive("output", "out-dir")
+	c.AddCommand(
+		newGenerateCommand(),
+		newValidateCommand(),
+		newDiffCommand(),
+		newFmtCommand(),
+		newImportCommand(),
+	)
 	return c
 }
 
+// Options configure ProcessInputWithOptions.
+type Options struct {
+	// Targets are the names of the generators to run. If
+	// empty, generator.DefaultTargets are generated.
+	Targets []string
+	// Strict rejects unknown fields in the definition.
+	Strict bool
+	// Config is the path of the validator configuration. If
+	// empty, validator.ConfigFile is used if it exists next to
+	// the input.
+	Config string
+	// Lockfile is the path of the lockfile. If empty,
+	// lock.File next to the input is used.
+	Lockfile string
+	// NoLockfile disables reading and writing the lockfile.
+	NoLockfile bool
+	// Quiet suppresses all progress output.
+	Quiet bool
+	// Verbose additionally echoes the input definition.
+	Verbose bool
+	// OutDir is the root of a tree the targets are written to,
+	// at the path derived from the API name and version by
+	// generator.NewLayout, e.g. com/example/bookstore/v1. It
+	// replaces the output prefix.
+	OutDir string
+	// GoPackagePrefix is the Go module path of OutDir, which
+	// the go_package of the proto is rooted at.
+	GoPackagePrefix string
+	// Buf additionally writes generator.BufFiles to OutDir.
+	Buf bool
+	// InputFormat is the format of the input, "yaml" or "json".
+	// If empty, it is determined from the extension of the input,
+	// or else from its content.
+	InputFormat string
+	// Stdout receives progress output. Defaults to os.Stdout,
+	// or to os.Stderr when the target is streamed to stdout.
+	Stdout io.Writer
+	// Output receives the target streamed with an output
+	// prefix of "-". Defaults to os.Stdout.
+	Output io.Writer
+}
+
+func (o Options) logf(format string, args ...any) {
+	if o.Quiet {
+		return
+	}
+	w := o.Stdout
+	if w == nil {
+		w = os.Stdout
+	}
+	fmt.Fprintf(w, format, args...)
+}
+
+func addOptionFlags(c *cobra.Command, opts *Options) {
+	c.Flags().StringSliceVar(&opts.Targets, "target", generator.DefaultTargets, fmt.Sprintf("targets to generate, any of %v", generator.Names()))
+	c.Flags().BoolVar(&opts.Strict, "strict", false, "reject unknown fields in the input")
+	c.Flags().StringVar(&opts.Config, "config", "", fmt.Sprintf("validator configuration, defaults to %s next to the input", validator.ConfigFile))
+	c.Flags().StringVar(&opts.Lockfile, "lockfile", "", fmt.Sprintf("lockfile recording the field numbers of the API, defaults to %s next to the input", lock.File))
+	c.Flags().BoolVar(&opts.NoLockfile, "no-lockfile", false, "neither check nor update the lockfile")
+	c.Flags().StringVar(&opts.OutDir, "out-dir", "", "root directory to write the targets to, in the directory of their proto package")
+	c.Flags().StringVar(&opts.GoPackagePrefix, "go-package-prefix", "", "Go module path of the --out-dir tree, prefixed to the go_package")
+	c.Flags().BoolVar(&opts.Buf, "buf", false, "also write a buf.yaml and buf.gen.yaml to --out-dir")
+	c.Flags().StringVar(&opts.InputFormat, "input-format", "", "format of the input, yaml or json, defaults to its extension or content")
+	c.Flags().BoolVarP(&opts.Quiet, "quiet", "q", false, "do not print progress output")
+	c.Flags().BoolVarP(&opts.Verbose, "verbose", "v", false, "also print the input")
+}
+
+// ProcessInput generates the default targets from inputFile.
 func ProcessInput(inputFile, outputFilePrefix string) error {
-	outputDir := filepath.Dir(outputFilePrefix)
-	input, err := ReadFile(inputFile)
-	fmt.Printf("input: %s\n", string(input))
+	return ProcessInputWithOptions(inputFile, outputFilePrefix, Options{})
+}
+
+// ProcessInputWithOptions validates inputFile and writes each
+// requested target to outputFilePrefix with the suffix of the
+// target's generator appended. If opts.OutDir is set, the
+// prefix is derived from the API instead.
+//
+// An inputFile of "-" reads the definition from stdin, with
+// imports resolved relative to the working directory and no
+// lockfile unless opts.Lockfile is set. An outputFilePrefix of
+// "-" streams the single requested target to opts.Output,
+// leaving the lockfile unchanged.
+func ProcessInputWithOptions(inputFile, outputFilePrefix string, opts Options) error {
+	if outputFilePrefix == "" && opts.OutDir == "" {
+		return fmt.Errorf("an output prefix or an output directory is required")
+	}
+	if opts.Buf && opts.OutDir == "" {
+		return fmt.Errorf("buf configuration can only be written with an output directory")
+	}
+	gens, err := generator.Resolve(opts.Targets)
 	if err != nil {
-		return fmt.Errorf("unable to read file: %w", err)
+		return err
+	}
+	stream := outputFilePrefix == stdio
+	if stream {
+		if len(gens) != 1 {
+			return fmt.Errorf("only a single target can be streamed to stdout, got %d, select one with --target", len(gens))
+		}
+		if opts.Stdout == nil {
+			opts.Stdout = os.Stderr
+		}
 	}
-	ext := filepath.Ext(inputFile)
-	a, err := deserializeAPI(ext, input)
+	input, err := readInput(inputFile)
 	if err != nil {
-		return fmt.Errorf("unable to unmarshal file: %w", err)
+		return fmt.Errorf("unable to read file: %w", err)
 	}
-	errors := validator.ValidateAPI(a)
-	if len(errors) > 0 {
-		return fmt.Errorf("error validating service: %v", errors)
+	if opts.Verbose {
+		opts.logf("input: %s\n", string(input))
 	}
-	proto, err := proto.APIToProtoString(a, outputDir)
+	d, err := parseDefinition(inputFile, input, loader.Options{Strict: opts.Strict, Format: opts.InputFormat})
 	if err != nil {
-		return fmt.Errorf("error writing service proto: %w", err)
+		return err
 	}
-	protoFile := fmt.Sprintf("%s.proto", outputFilePrefix)
-	err = WriteFile(protoFile, proto)
+	lockfile := lockPath(inputFile, opts.Lockfile)
+	var l *lock.Lock
+	if !opts.NoLockfile {
+		l, err = readLock(inputFile, opts.Lockfile)
+		if err != nil {
+			return err
+		}
+	}
+	generator.AssignFieldNumbers(d, l)
+	cfg, err := lintConfig(inputFile, opts.Config, d)
 	if err != nil {
-		return fmt.Errorf("error writing file: %w", err)
+		return err
+	}
+	diags := locateDiagnostics(validator.ValidateDefinition(d, cfg), d.Source)
+	errs := []validator.Diagnostic{}
+	for _, d := range diags {
+		switch {
+		case d.Suppressed:
+		case d.Severity == validator.SeverityError:
+			errs = append(errs, d)
+		default:
+			opts.logf("%s: %v\n", d.Severity, d)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("error validating service: %v", errs)
+	}
+	if n := validator.Suppressed(diags); n > 0 {
+		opts.logf("findings suppressed: %d\n", n)
+	}
+	genOpts := generator.Options{
+		OutputDir: filepath.Dir(outputFilePrefix),
+	}
+	if opts.OutDir != "" {
+		layout, err := generator.NewLayout(d.API.Name, d.Version, opts.GoPackagePrefix)
+		if err != nil {
+			return err
+		}
+		genOpts.OutputDir = filepath.Join(opts.OutDir, filepath.FromSlash(layout.Dir))
+		genOpts.Layout = &layout
+		outputFilePrefix = filepath.Join(genOpts.OutputDir, layout.Base)
+		if err := os.MkdirAll(genOpts.OutputDir, 0755); err != nil {
+			return fmt.Errorf("error creating output directory: %w", err)
+		}
+	}
+	if l != nil && !stream {
+		if err := updateLock(l, lockfile, d, genOpts); err != nil {
+			return err
+		}
+	}
+	genOpts.Lock = l
+	for _, g := range gens {
+		output, err := generator.Run(g, d, genOpts)
+		if err != nil {
+			return err
+		}
+		if stream {
+			w := opts.Output
+			if w == nil {
+				w = os.Stdout
+			}
+			if _, err := w.Write(output); err != nil {
+				return fmt.Errorf("error writing output: %w", err)
+			}
+			continue
+		}
+		outputFile := outputFilePrefix + g.Suffix()
+		err = WriteFile(outputFile, output)
+		if err != nil {
+			return fmt.Errorf("error writing file: %w", err)
+		}
+		opts.logf("output %s file: %s\n", g.Name(), outputFile)
+	}
+	if opts.Buf {
+		if err := writeBufFiles(opts); err != nil {
+			return err
+		}
+	}
+	// a streamed target is not written next to the lockfile,
+	// which is left as is.
+	if genOpts.Lock != nil && !stream {
+		b, err := genOpts.Lock.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := WriteFile(lockfile, b); err != nil {
+			return fmt.Errorf("error writing lockfile: %w", err)
+		}
+		opts.logf("output lockfile: %s\n", lockfile)
+	}
+	return nil
+}
+
+// generate runs ProcessInputWithOptions for a single input, and
+// ProcessInputsWithOptions for several.
+func generate(inputFiles []string, outputFilePrefix string, opts Options) error {
+	if len(inputFiles) <= 1 {
+		inputFile := ""
+		if len(inputFiles) == 1 {
+			inputFile = inputFiles[0]
+		}
+		return ProcessInputWithOptions(inputFile, outputFilePrefix, opts)
+	}
+	if outputFilePrefix != "" {
+		return fmt.Errorf("several inputs can only be generated with an output directory")
+	}
+	return ProcessInputsWithOptions(inputFiles, opts)
+}
+
+// ProcessInputsWithOptions generates each of inputFiles, such
+// as several versions of an API, side by side into opts.OutDir.
+// It fails before generating anything if two of them would be
+// written to the same directory, or would share the lockfile
+// next to them.
+func ProcessInputsWithOptions(inputFiles []string, opts Options) error {
+	if opts.OutDir == "" {
+		return fmt.Errorf("an output directory is required to generate several inputs")
+	}
+	if opts.Lockfile != "" {
+		return fmt.Errorf("a lockfile cannot be shared by several inputs")
 	}
-	fmt.Printf("output proto file: %s\n", protoFile)
-	openapi, err := a.ConvertToOpenAPIBytes()
+	dirs, lockfiles := map[string]string{}, map[string]string{}
+	for _, inputFile := range inputFiles {
+		if inputFile == stdio {
+			return fmt.Errorf("stdin can only be read as a single input")
+		}
+		input, err := readInput(inputFile)
+		if err != nil {
+			return fmt.Errorf("unable to read file: %w", err)
+		}
+		d, err := parseDefinition(inputFile, input, loader.Options{Strict: opts.Strict, Format: opts.InputFormat})
+		if err != nil {
+			return err
+		}
+		layout, err := generator.NewLayout(d.API.Name, d.Version, opts.GoPackagePrefix)
+		if err != nil {
+			return fmt.Errorf("%s: %w", inputFile, err)
+		}
+		if other, ok := dirs[layout.Dir]; ok {
+			return fmt.Errorf("%s and %s are both generated to %s, declare a different name or version", other, inputFile, layout.Dir)
+		}
+		dirs[layout.Dir] = inputFile
+		if opts.NoLockfile {
+			continue
+		}
+		lockfile, err := filepath.Abs(lockPath(inputFile, ""))
+		if err != nil {
+			return err
+		}
+		if other, ok := lockfiles[lockfile]; ok {
+			return fmt.Errorf("%s and %s share the lockfile %s, move them to different directories", other, inputFile, lockPath(inputFile, ""))
+		}
+		lockfiles[lockfile] = inputFile
+	}
+	for _, inputFile := range inputFiles {
+		if err := ProcessInputWithOptions(inputFile, "", opts); err != nil {
+			return fmt.Errorf("%s: %w", inputFile, err)
+		}
+	}
+	return nil
+}
+
+// writeBufFiles writes generator.BufFiles to opts.OutDir.
+func writeBufFiles(opts Options) error {
+	names := []string{}
+	for name := range generator.BufFiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		file := filepath.Join(opts.OutDir, name)
+		if err := WriteFile(file, []byte(generator.BufFiles[name])); err != nil {
+			return fmt.Errorf("error writing file: %w", err)
+		}
+		opts.logf("output buf file: %s\n", file)
+	}
+	return nil
+}
+
+// lockPath returns lockfile, or lock.File next to inputFile if
+// it is empty.
+func lockPath(inputFile, lockfile string) string {
+	if lockfile != "" {
+		return lockfile
+	}
+	return filepath.Join(filepath.Dir(inputFile), lock.File)
+}
+
+// readLock reads the lock of inputFile from lockfile, or from
+// lock.File next to inputFile if it is empty. It returns nil
+// for stdin, unless lockfile is set.
+func readLock(inputFile, lockfile string) (*lock.Lock, error) {
+	if inputFile == stdio && lockfile == "" {
+		return nil, nil
+	}
+	l, err := lock.Read(lockPath(inputFile, lockfile))
 	if err != nil {
-		return fmt.Errorf("error building openapi: %w", err)
+		return nil, fmt.Errorf("unable to read lockfile: %w", err)
 	}
-	openapiFile := fmt.Sprintf("%s_openapi.json", outputFilePrefix)
-	err = WriteFile(openapiFile, openapi)
+	return l, nil
+}
+
+// loadNumberedDefinition is loadDefinition, additionally
+// assigning the field numbers the definition omits from the
+// lock of inputFile, as ProcessInputWithOptions does, so that
+// they are checked or compared as generated.
+func loadNumberedDefinition(inputFile string, strict bool, lockfile string) (*loader.Definition, error) {
+	d, err := loadDefinition(inputFile, strict)
 	if err != nil {
-		return fmt.Errorf("error writing file: %w", err)
+		return nil, err
 	}
-	fmt.Printf("output openapi file: %s\n", openapiFile)
-	yamlOpenAPI, err := yaml.JSONToYAML(openapi)
+	l, err := readLock(inputFile, lockfile)
 	if err != nil {
-		return fmt.Errorf("error converting openapi json to yaml: %w", err)
+		return nil, err
 	}
-	yamlOpenAPIFile := fmt.Sprintf("%s_openapi.yaml", outputFilePrefix)
-	err = WriteFile(yamlOpenAPIFile, yamlOpenAPI)
+	generator.AssignFieldNumbers(d, l)
+	return d, nil
+}
+
+// updateLock records the messages generated for d in l, the
+// lock read from lockfile, failing if d reuses the number of a
+// retired field.
+func updateLock(l *lock.Lock, lockfile string, d *loader.Definition, opts generator.Options) error {
+	messages, err := generator.ProtoMessages(d, opts)
 	if err != nil {
-		return fmt.Errorf("error writing yaml file: %w", err)
+		return err
+	}
+	if err := l.Update(messages); err != nil {
+		return fmt.Errorf("%s: %w", lockfile, err)
 	}
-	fmt.Printf("output openapi yaml file: %s\n", yamlOpenAPIFile)
 	return nil
 }
 
-func deserializeAPI(ext string, b []byte) (*api.API, error) {
-	switch ext {
-	case ".yaml":
-		asJson, err := yaml.YAMLToJSON(b)
+// loadDefinition reads and deserializes the resource
+// definition in inputFile, without validating it.
+func loadDefinition(inputFile string, strict bool) (*loader.Definition, error) {
+	input, err := readInput(inputFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read file: %w", err)
+	}
+	return parseDefinition(inputFile, input, loader.Options{Strict: strict})
+}
+
+// parseDefinition deserializes input, read from inputFile.
+// opts.Format is given as "yaml" or "json". Failures are
+// returned as loader.Error or loader.Errors.
+func parseDefinition(inputFile string, input []byte, opts loader.Options) (*loader.Definition, error) {
+	switch opts.Format {
+	case "":
+	case "yaml", "json":
+		opts.Format = "." + opts.Format
+	default:
+		return nil, fmt.Errorf("unsupported input format %q, must be yaml or json", opts.Format)
+	}
+	if inputFile == stdio {
+		inputFile = stdinName
+	}
+	return loader.Load(inputFile, input, opts)
+}
+
+const (
+	// stdio is the file name standing for stdin as an input,
+	// and for stdout as an output.
+	stdio = "-"
+	// stdinName is the name of stdin in error messages.
+	stdinName = "<stdin>"
+)
+
+// stdin is read for an input of stdio.
+var stdin io.Reader = os.Stdin
+
+// readInput reads inputFile, or stdin if it is stdio.
+func readInput(inputFile string) ([]byte, error) {
+	if inputFile == stdio {
+		return io.ReadAll(stdin)
+	}
+	return ReadFile(inputFile)
+}
+
+// lintConfig returns the validator configuration for d, read
+// from configFile, or from validator.ConfigFile next to
+// inputFile if it exists. The x-aep-lint block of d is merged
+// over it.
+func lintConfig(inputFile, configFile string, d *loader.Definition) (*validator.Config, error) {
+	if configFile == "" {
+		candidate := filepath.Join(filepath.Dir(inputFile), validator.ConfigFile)
+		if _, err := os.Stat(candidate); err == nil {
+			configFile = candidate
+		}
+	}
+	var cfg *validator.Config
+	if configFile != "" {
+		b, err := ReadFile(configFile)
 		if err != nil {
-			return nil, fmt.Errorf("unable to decode yaml to JSON %q: %w", string(b), err)
+			return nil, fmt.Errorf("unable to read config: %w", err)
 		}
-		api, err := api.LoadAPIFromJson(asJson)
+		cfg, err = validator.ParseConfig(b)
 		if err != nil {
-			log.Fatal(fmt.Errorf("unable to unmarshal json %q: %w", string(b), err))
+			return nil, fmt.Errorf("%s: %w", configFile, err)
 		}
-		return api, nil
-	case ".json":
-		api, err := api.LoadAPIFromJson(b)
+	}
+	if d.Lint != nil {
+		block, err := validator.ParseConfig(d.Lint)
 		if err != nil {
-			log.Fatal(fmt.Errorf("unable to unmarshal json %q: %w", string(b), err))
+			return nil, fmt.Errorf("%s: x-aep-lint: %w", inputFile, err)
+		}
+		cfg = cfg.Merge(block)
+	}
+	return cfg, nil
+}
+
+// locateDiagnostics sets the source position of each
+// diagnostic, based on its location in the definition.
+func locateDiagnostics(diags []validator.Diagnostic, src loader.SourceMap) []validator.Diagnostic {
+	for i, d := range diags {
+		if pos, ok := src.Lookup(d.Location); ok {
+			diags[i].File = pos.File
+			diags[i].Line = pos.Line
+			diags[i].Column = pos.Column
 		}
-		return api, nil
-	default:
-		return nil, fmt.Errorf("extension %v is unsupported", ext)
 	}
+	return diags
 }
 
 func ReadFile(fileName string) ([]byte, error) {
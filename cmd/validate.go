@@ -0,0 +1,91 @@
+// Copyright 2023 Yusuke Fredrick Tsutsumi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/aep-dev/aepc/lock"
+	"github.com/aep-dev/aepc/validator"
+	"github.com/spf13/cobra"
+)
+
+func newValidateCommand() *cobra.Command {
+	var inputFile string
+	var format string
+	var strict bool
+	var config string
+	var listRules bool
+	var lockfile string
+
+	c := &cobra.Command{
+		Use:   "validate",
+		Short: "validate a resource definition without writing any output",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if listRules {
+				return writeRules(cmd.OutOrStdout())
+			}
+			if inputFile == "" {
+				return fmt.Errorf(`required flag(s) "input" not set`)
+			}
+			d, err := loadNumberedDefinition(inputFile, strict, lockfile)
+			if err != nil {
+				// report malformed input like any other
+				// finding, so that it can be parsed in every
+				// format.
+				diags, ok := validator.LoadDiagnostics(err)
+				if !ok {
+					return err
+				}
+				if err := validator.WriteDiagnostics(cmd.OutOrStdout(), format, inputFile, diags); err != nil {
+					return err
+				}
+				return fmt.Errorf("%s: validation failed", inputFile)
+			}
+			cfg, err := lintConfig(inputFile, config, d)
+			if err != nil {
+				return err
+			}
+			diags := locateDiagnostics(validator.ValidateDefinition(d, cfg), d.Source)
+			err = validator.WriteDiagnostics(cmd.OutOrStdout(), format, inputFile, diags)
+			if err != nil {
+				return err
+			}
+			if validator.HasErrors(diags) {
+				return fmt.Errorf("%s: validation failed", inputFile)
+			}
+			return nil
+		},
+	}
+	c.Flags().StringVarP(&inputFile, "input", "i", "", "input files with resource")
+	c.Flags().StringVar(&format, "format", validator.FormatText, fmt.Sprintf("output format of the diagnostics, one of %v", validator.Formats))
+	c.Flags().BoolVar(&strict, "strict", false, "reject unknown fields in the input")
+	c.Flags().StringVar(&config, "config", "", fmt.Sprintf("validator configuration, defaults to %s next to the input", validator.ConfigFile))
+	c.Flags().StringVar(&lockfile, "lockfile", "", fmt.Sprintf("lockfile the omitted field numbers are assigned from, defaults to %s next to the input", lock.File))
+	c.Flags().BoolVar(&listRules, "list-rules", false, "list the rules of the validator and exit")
+	return c
+}
+
+// writeRules writes the ID, severity and description of every
+// rule of the validator to w.
+func writeRules(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, r := range validator.Rules() {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", r.ID, r.Severity, r.Description)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,373 @@
+// Package diff compares two versions of an API, classifying
+// each change as breaking for existing clients or not.
+package diff
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+	"github.com/aep-dev/aepc/loader"
+)
+
+// Kind classifies a Change.
+type Kind string
+
+const (
+	ResourceAdded       Kind = "resource-added"
+	ResourceRemoved     Kind = "resource-removed"
+	ParentChanged       Kind = "parent-changed"
+	MethodAdded         Kind = "method-added"
+	MethodRemoved       Kind = "method-removed"
+	MethodHTTPChanged   Kind = "method-http-changed"
+	MethodLROChanged    Kind = "method-lro-changed"
+	SchemaAdded         Kind = "schema-added"
+	SchemaRemoved       Kind = "schema-removed"
+	FieldAdded          Kind = "field-added"
+	FieldAddedRequired  Kind = "field-added-required"
+	FieldRemoved        Kind = "field-removed"
+	FieldRenumbered     Kind = "field-renumbered"
+	FieldTypeChanged    Kind = "field-type-changed"
+	FieldMadeRequired   Kind = "field-made-required"
+	FieldMadeOptional   Kind = "field-made-optional"
+	FieldMadeReadOnly   Kind = "field-made-read-only"
+	FieldMadeWritable   Kind = "field-made-writable"
+	EnumAdded           Kind = "enum-added"
+	EnumRemoved         Kind = "enum-removed"
+	EnumValueAdded      Kind = "enum-value-added"
+	EnumValueRemoved    Kind = "enum-value-removed"
+	APINameChanged      Kind = "api-name-changed"
+	APIServerURLChanged Kind = "api-server-url-changed"
+)
+
+// breaking lists the kinds of change that break existing
+// clients, either on the wire or in generated code.
+var breaking = map[Kind]bool{
+	ResourceRemoved:    true,
+	ParentChanged:      true,
+	MethodRemoved:      true,
+	MethodHTTPChanged:  true,
+	MethodLROChanged:   true,
+	SchemaRemoved:      true,
+	FieldAddedRequired: true,
+	FieldRemoved:       true,
+	FieldRenumbered:    true,
+	FieldTypeChanged:   true,
+	FieldMadeRequired:  true,
+	FieldMadeReadOnly:  true,
+	// an enum changes the type of a string field in proto.
+	EnumAdded:        true,
+	EnumRemoved:      true,
+	EnumValueRemoved: true,
+	APINameChanged:   true,
+}
+
+// Breaking reports whether changes of kind k break existing
+// clients.
+func (k Kind) Breaking() bool {
+	return breaking[k]
+}
+
+// Change is a single difference between two APIs.
+type Change struct {
+	Kind Kind `json:"kind"`
+	// Breaking is set if the change breaks existing clients.
+	Breaking bool `json:"breaking"`
+	// Location is a JSON pointer into the definition holding
+	// the change: the new definition, unless the change is a
+	// removal.
+	Location string `json:"location"`
+	// Message is a human-readable description of the change.
+	Message string `json:"message"`
+}
+
+func (c Change) String() string {
+	s := fmt.Sprintf("%s: %s [%s]", c.Location, c.Message, c.Kind)
+	if c.Breaking {
+		s += " (breaking)"
+	}
+	return s
+}
+
+// HasBreaking returns true if any of changes is breaking.
+func HasBreaking(changes []Change) bool {
+	return slices.ContainsFunc(changes, func(c Change) bool { return c.Breaking })
+}
+
+type differ struct {
+	changes []Change
+}
+
+func (d *differ) add(kind Kind, loc, format string, args ...any) {
+	d.changes = append(d.changes, Change{
+		Kind:     kind,
+		Breaking: kind.Breaking(),
+		Location: loc,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// Compare returns the changes from the definition oldDef to
+// newDef, ordered by resource, then by shared schema, then by
+// enum.
+func Compare(oldDef, newDef *loader.Definition) []Change {
+	oldAPI, newAPI := oldDef.API, newDef.API
+	d := &differ{}
+	if oldAPI.Name != newAPI.Name {
+		d.add(APINameChanged, pointer("name"), "name changed from %q to %q", oldAPI.Name, newAPI.Name)
+	}
+	if oldAPI.ServerURL != newAPI.ServerURL {
+		d.add(APIServerURLChanged, pointer("server_url"), "server URL changed from %q to %q", oldAPI.ServerURL, newAPI.ServerURL)
+	}
+	for _, name := range sortedKeys(oldAPI.Resources, newAPI.Resources) {
+		oldR, inOld := oldAPI.Resources[name]
+		newR, inNew := newAPI.Resources[name]
+		loc := pointer("resources", name)
+		switch {
+		case !inNew:
+			d.add(ResourceRemoved, loc, "resource %q removed", name)
+		case !inOld:
+			d.add(ResourceAdded, loc, "resource %q added", name)
+		default:
+			d.compareResource(loc, name, oldR, newR)
+		}
+	}
+	for _, name := range sortedKeys(oldAPI.Schemas, newAPI.Schemas) {
+		oldS, inOld := oldAPI.Schemas[name]
+		newS, inNew := newAPI.Schemas[name]
+		loc := pointer("schemas", name)
+		switch {
+		case !inNew:
+			d.add(SchemaRemoved, loc, "schema %q removed", name)
+		case !inOld:
+			d.add(SchemaAdded, loc, "schema %q added", name)
+		default:
+			d.compareSchema(loc, oldS, newS)
+		}
+	}
+	d.compareEnums(oldDef, newDef)
+	return d.changes
+}
+
+// compareEnums records the enums added to and removed from the
+// fields of both definitions, and the changes to their values.
+// The enums of added and removed fields are reported with the
+// field.
+func (d *differ) compareEnums(oldDef, newDef *loader.Definition) {
+	oldEnums, newEnums := map[string]loader.Enum{}, map[string]loader.Enum{}
+	for _, e := range oldDef.Enums {
+		oldEnums[e.Pointer] = e
+	}
+	for _, e := range newDef.Enums {
+		newEnums[e.Pointer] = e
+	}
+	for _, p := range sortedKeys(oldEnums, newEnums) {
+		oldE, inOld := oldEnums[p]
+		newE, inNew := newEnums[p]
+		switch {
+		case !inNew:
+			if oldE.Lookup(newDef.API) != nil {
+				d.add(EnumRemoved, p+pointer("enum"), "enum of field %q removed", oldE.Property())
+			}
+		case !inOld:
+			if newE.Lookup(oldDef.API) != nil {
+				d.add(EnumAdded, p+pointer("enum"), "enum added to field %q", newE.Property())
+			}
+		default:
+			for i, v := range oldE.Values {
+				if !slices.Contains(newE.Values, v) {
+					d.add(EnumValueRemoved, p+pointer("enum", strconv.Itoa(i)), "value %q removed from the enum of field %q", v, oldE.Property())
+				}
+			}
+			for i, v := range newE.Values {
+				if !slices.Contains(oldE.Values, v) {
+					d.add(EnumValueAdded, p+pointer("enum", strconv.Itoa(i)), "value %q added to the enum of field %q", v, newE.Property())
+				}
+			}
+		}
+	}
+}
+
+func (d *differ) compareResource(loc, name string, oldR, newR *api.Resource) {
+	if !slices.Equal(oldR.Parents, newR.Parents) {
+		d.add(ParentChanged, loc+pointer("parents"), "parents of resource %q changed from %v to %v", name, oldR.Parents, newR.Parents)
+	}
+	oldMethods := methods(oldR)
+	newMethods := methods(newR)
+	for _, m := range sortedKeys(oldMethods, newMethods) {
+		oldM, inOld := oldMethods[m]
+		newM, inNew := newMethods[m]
+		switch {
+		case !inNew:
+			d.add(MethodRemoved, oldM.loc(loc, m), "method %q removed from resource %q", m, name)
+		case !inOld:
+			d.add(MethodAdded, newM.loc(loc, m), "method %q added to resource %q", m, name)
+		default:
+			if oldM.http != newM.http {
+				d.add(MethodHTTPChanged, newM.loc(loc, m)+pointer("method"), "HTTP method of %q changed from %s to %s", m, oldM.http, newM.http)
+			}
+			if oldM.longRunning != newM.longRunning {
+				d.add(MethodLROChanged, newM.loc(loc, m)+pointer("is_long_running"), "method %q is_long_running changed from %v to %v", m, oldM.longRunning, newM.longRunning)
+			}
+		}
+	}
+	d.compareSchema(loc+pointer("schema"), oldR.Schema, newR.Schema)
+	for _, m := range sortedKeys(oldMethods, newMethods) {
+		oldM, inOld := oldMethods[m]
+		newM, inNew := newMethods[m]
+		if inOld && inNew && oldM.custom != nil {
+			cmLoc := newM.loc(loc, m)
+			d.compareSchema(cmLoc+pointer("request"), oldM.custom.Request, newM.custom.Request)
+			d.compareSchema(cmLoc+pointer("response"), oldM.custom.Response, newM.custom.Response)
+		}
+	}
+}
+
+// method describes a standard or custom method of a resource.
+type method struct {
+	// index of a custom method in the custom methods of the
+	// resource, -1 for standard methods.
+	index       int
+	custom      *api.CustomMethod
+	http        string
+	longRunning bool
+}
+
+func (m method) loc(resourceLoc, key string) string {
+	if m.index < 0 {
+		return resourceLoc + pointer("methods", key)
+	}
+	return resourceLoc + pointer("custom_methods", strconv.Itoa(m.index))
+}
+
+// methods returns the standard and custom methods defined on a
+// resource. Custom methods are keyed as ":name".
+func methods(r *api.Resource) map[string]method {
+	ms := map[string]method{}
+	if r.Methods.Get != nil {
+		ms["get"] = method{index: -1}
+	}
+	if r.Methods.List != nil {
+		ms["list"] = method{index: -1}
+	}
+	if r.Methods.Create != nil {
+		ms["create"] = method{index: -1, longRunning: r.Methods.Create.IsLongRunning}
+	}
+	if r.Methods.Update != nil {
+		ms["update"] = method{index: -1, longRunning: r.Methods.Update.IsLongRunning}
+	}
+	if r.Methods.Delete != nil {
+		ms["delete"] = method{index: -1, longRunning: r.Methods.Delete.IsLongRunning}
+	}
+	if r.Methods.Apply != nil {
+		ms["apply"] = method{index: -1, longRunning: r.Methods.Apply.IsLongRunning}
+	}
+	for i, cm := range r.CustomMethods {
+		ms[":"+cm.Name] = method{index: i, custom: cm, http: cm.Method, longRunning: cm.IsLongRunning}
+	}
+	return ms
+}
+
+// compareSchema records the changes to the properties of a
+// schema that generates a message, recursing into nested
+// messages.
+func (d *differ) compareSchema(loc string, oldS, newS *openapi.Schema) {
+	if oldS == nil || newS == nil {
+		return
+	}
+	for _, name := range sortedKeys(oldS.Properties, newS.Properties) {
+		oldP, inOld := oldS.Properties[name]
+		newP, inNew := newS.Properties[name]
+		pLoc := loc + pointer("properties", name)
+		oldRequired := slices.Contains(oldS.Required, name)
+		newRequired := slices.Contains(newS.Required, name)
+		switch {
+		case !inNew:
+			d.add(FieldRemoved, pLoc, "field %q removed", name)
+			continue
+		case !inOld && newRequired:
+			d.add(FieldAddedRequired, pLoc, "required field %q added", name)
+			continue
+		case !inOld:
+			d.add(FieldAdded, pLoc, "field %q added", name)
+			continue
+		}
+		if oldN, newN := fieldNumber(oldP), fieldNumber(newP); oldN != newN {
+			d.add(FieldRenumbered, pLoc+pointer("x-aep-field", "field_number"), "field %q renumbered from %d to %d", name, oldN, newN)
+		}
+		if oldT, newT := typeName(&oldP), typeName(&newP); oldT != newT {
+			d.add(FieldTypeChanged, pLoc, "type of field %q changed from %s to %s", name, oldT, newT)
+		} else {
+			d.compareSchema(pLoc, &oldP, &newP)
+			if oldP.Items != nil && newP.Items != nil {
+				d.compareSchema(pLoc+pointer("items"), oldP.Items, newP.Items)
+			}
+		}
+		switch {
+		case !oldRequired && newRequired:
+			d.add(FieldMadeRequired, pLoc, "field %q made required", name)
+		case oldRequired && !newRequired:
+			d.add(FieldMadeOptional, pLoc, "field %q made optional", name)
+		}
+		switch {
+		case !oldP.ReadOnly && newP.ReadOnly:
+			d.add(FieldMadeReadOnly, pLoc, "field %q made read-only", name)
+		case oldP.ReadOnly && !newP.ReadOnly:
+			d.add(FieldMadeWritable, pLoc, "field %q made writable", name)
+		}
+	}
+}
+
+func fieldNumber(s openapi.Schema) int {
+	if s.XAEPField == nil {
+		return 0
+	}
+	return s.XAEPField.FieldNumber
+}
+
+// typeName describes the type of a property, e.g. "integer
+// (int32)", "array of string" or "#/components/schemas/money".
+func typeName(s *openapi.Schema) string {
+	switch {
+	case s.Ref != "":
+		return s.Ref
+	case s.Type == "array" && s.Items != nil:
+		return "array of " + typeName(s.Items)
+	case s.Format != "":
+		return fmt.Sprintf("%s (%s)", s.Type, s.Format)
+	case s.Type == "":
+		return "unspecified"
+	}
+	return s.Type
+}
+
+// pointer builds a JSON pointer from unescaped tokens.
+func pointer(tokens ...string) string {
+	var b strings.Builder
+	for _, t := range tokens {
+		t = strings.ReplaceAll(t, "~", "~0")
+		t = strings.ReplaceAll(t, "/", "~1")
+		b.WriteString("/" + t)
+	}
+	return b.String()
+}
+
+// sortedKeys returns the sorted union of the keys of a and b.
+func sortedKeys[V any](a, b map[string]V) []string {
+	seen := map[string]bool{}
+	keys := []string{}
+	for _, m := range []map[string]V{a, b} {
+		for k := range m {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
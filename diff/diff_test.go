@@ -0,0 +1,101 @@
+package diff
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aep-dev/aepc/loader"
+)
+
+const base = `name: bookstore.example.com
+resources:
+  publisher:
+    singular: publisher
+    plural: publishers
+    schema: {}
+    methods: {get: {}}
+  book:
+    singular: book
+    plural: books
+    parents: ["publisher"]
+    schema:
+      required: ["isbn"]
+      properties:
+        isbn: {type: string, x-aep-field: {field_number: 1}}
+        price: {type: number, format: double, x-aep-field: {field_number: 2}}
+        condition: {type: string, enum: [NEW, USED, REFURBISHED], x-aep-field: {field_number: 3}}
+    methods: {get: {}, list: {}}
+    custom_methods:
+      - {name: archive, method: POST, is_long_running: true}
+`
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want []Kind
+	}{
+		{"unchanged", "", "", []Kind{}},
+		{"resource removed", "  publisher:\n    singular: publisher\n    plural: publishers\n    schema: {}\n    methods: {get: {}}\n", "", []Kind{ResourceRemoved}},
+		{"method removed", "list: {}", "", []Kind{MethodRemoved}},
+		{"method added", "get: {}, list: {}", "get: {}, list: {}, delete: {}", []Kind{MethodAdded}},
+		{"field renumbered", "field_number: 2", "field_number: 3", []Kind{FieldRenumbered}},
+		{"type changed", "type: number, format: double", "type: string", []Kind{FieldTypeChanged}},
+		{"made required", `required: ["isbn"]`, `required: ["isbn", "price"]`, []Kind{FieldMadeRequired}},
+		{"made optional", `required: ["isbn"]`, `required: []`, []Kind{FieldMadeOptional}},
+		{"parent changed", `parents: ["publisher"]`, `parents: []`, []Kind{ParentChanged}},
+		{"lro flipped", "is_long_running: true", "is_long_running: false", []Kind{MethodLROChanged}},
+		{"field added", "        price:", "        title: {type: string, x-aep-field: {field_number: 4}}\n        price:", []Kind{FieldAdded}},
+		{"enum value removed", "[NEW, USED, REFURBISHED]", "[NEW, REFURBISHED]", []Kind{EnumValueRemoved}},
+		{"enum value added", "[NEW, USED, REFURBISHED]", "[NEW, USED, REFURBISHED, DAMAGED]", []Kind{EnumValueAdded}},
+		{"enum removed", "enum: [NEW, USED, REFURBISHED], ", "", []Kind{EnumRemoved}},
+		{"enum added", "type: number, format: double,", "type: string, enum: [LOW, HIGH],", []Kind{FieldTypeChanged, EnumAdded}},
+		{"enumerated field removed", "        condition: {type: string, enum: [NEW, USED, REFURBISHED], x-aep-field: {field_number: 3}}\n", "", []Kind{FieldRemoved}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			to := base
+			if tt.from != "" || tt.to != "" {
+				to = replaceOnce(t, base, tt.from, tt.to)
+			}
+			got := []Kind{}
+			for _, c := range Compare(load(t, base), load(t, to)) {
+				got = append(got, c.Kind)
+				if c.Breaking != c.Kind.Breaking() {
+					t.Errorf("Compare() change %v breaking = %v", c, c.Breaking)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHasBreaking(t *testing.T) {
+	if HasBreaking([]Change{{Kind: FieldAdded}}) {
+		t.Errorf("HasBreaking() = true for a non-breaking change")
+	}
+	if !HasBreaking([]Change{{Kind: FieldAdded}, {Kind: FieldRemoved, Breaking: true}}) {
+		t.Errorf("HasBreaking() = false for a breaking change")
+	}
+}
+
+func replaceOnce(t *testing.T, s, old, new string) string {
+	t.Helper()
+	if !strings.Contains(s, old) {
+		t.Fatalf("%q not found", old)
+	}
+	return strings.Replace(s, old, new, 1)
+}
+
+func load(t *testing.T, input string) *loader.Definition {
+	t.Helper()
+	d, err := loader.Load("f.yaml", []byte(input), loader.Options{})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	return d
+}
@@ -0,0 +1,11 @@
+package bookstore
+
+import (
+	_ "embed"
+)
+
+// Definition is the resource definition the bookstore API is
+// generated from.
+//
+//go:embed bookstore.yaml
+var Definition []byte
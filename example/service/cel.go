@@ -1,29 +1,53 @@
 package service
 
 import (
+	"sync"
+
+	bpb "github.com/aep-dev/aepc/example/bookstore/v1"
+	"github.com/aep-dev/aepc/loader"
 	"github.com/aep-dev/aepc/pkg/cel2ansisql"
-	"github.com/google/cel-go/cel"
 )
 
-func convertCELToSQL(expr string) (string, error) {
+// bookstoreDefinition is the bookstore definition, loaded on
+// first use.
+var bookstoreDefinition = sync.OnceValues(func() (*loader.Definition, error) {
+	return loader.Load("bookstore.yaml", bpb.Definition, loader.Options{})
+})
+
+// convertCELToSQL converts the filter expr on the resource to
+// an SQLite condition, and the arguments bound to its
+// placeholders. expr may reference the fields of the resource
+// schema, which are mapped to the columns of the same name.
+func convertCELToSQL(resource, expr string) (string, []any, error) {
 	if expr == "" {
-		return "", nil
+		return "", nil, nil
 	}
-	env, err := cel.NewEnv(
-		cel.Variable("path", cel.StringType),
-		cel.Variable("description", cel.StringType),
-	)
+	d, err := bookstoreDefinition()
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
-	ast, iss := env.Compile(expr)
-	if iss.Err() != nil {
-		return "", iss.Err()
+	fields, err := cel2ansisql.ResourceFields(d.API, resource)
+	if err != nil {
+		return "", nil, err
 	}
+	return convertFilter(fields, expr)
+}
 
-	sql, err := cel2ansisql.ConvertToSQL(ast)
+// convertFilter converts the filter expr, which may reference
+// fields, to an SQLite condition, and the arguments bound to its
+// placeholders.
+func convertFilter(fields cel2ansisql.Fields, expr string) (string, []any, error) {
+	env, err := fields.NewEnv()
 	if err != nil {
-		return "", err
+		return "", nil, err
+	}
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return "", nil, iss.Err()
 	}
-	return sql, nil
+	return cel2ansisql.Converter{
+		Dialect:     cel2ansisql.SQLite,
+		Placeholder: cel2ansisql.QuestionMark,
+		Fields:      fields,
+	}.Convert(ast)
 }
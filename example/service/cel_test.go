@@ -1,22 +1,54 @@
 package service
 
 import (
+	"database/sql"
+	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/aep-dev/aepc/pkg/cel2ansisql"
+	"github.com/google/cel-go/cel"
 )
 
 func TestCELToSQL(t *testing.T) {
 	tests := []struct {
-		name     string
-		input    string
-		expected string
+		name         string
+		resource     string
+		input        string
+		expected     string
+		expectedArgs []any
+		wantErr      string
 	}{
 		{
-			name:     "simple expression",
-			input:    "description.startsWith('tomorrow')",
-			expected: "description LIKE CONCAT('tomorrow', '%')",
+			name:         "simple expression",
+			resource:     "publisher",
+			input:        "description.startsWith('tomorrow')",
+			expected:     `"description" GLOB ?`,
+			expectedArgs: []any{"tomorrow*"},
+		},
+		{
+			name:         "quotes are bound rather than inlined",
+			resource:     "publisher",
+			input:        `path == "publishers/1' OR 1=1 --"`,
+			expected:     `"path" = ?`,
+			expectedArgs: []any{"publishers/1' OR 1=1 --"},
+		},
+		{
+			name:         "fields of other resources",
+			resource:     "book",
+			input:        "published && edition >= 2",
+			expected:     `"published" AND "edition" >= ?`,
+			expectedArgs: []any{int64(2)},
+		},
+		{
+			name:     "unknown field",
+			resource: "publisher",
+			input:    "edition == 1",
+			wantErr:  "undeclared reference to 'edition'",
 		},
 		{
 			name:     "empty",
+			resource: "publisher",
 			input:    "",
 			expected: "",
 		},
@@ -24,13 +56,130 @@ func TestCELToSQL(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := convertCELToSQL(tt.input)
+			got, args, err := convertCELToSQL(tt.resource, tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("convertCELToSQL() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
 			if err != nil {
 				t.Errorf("convertCELToSQL() = %v, want %v", err, tt.expected)
 			}
 			if got != tt.expected {
 				t.Errorf("convertCELToSQL() = %v, want %v", got, tt.expected)
 			}
+			if !reflect.DeepEqual(args, tt.expectedArgs) {
+				t.Errorf("convertCELToSQL() args = %v, want %v", args, tt.expectedArgs)
+			}
 		})
 	}
 }
+
+func TestCELToSQLOnBooks(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`
+		INSERT INTO books (path, author, price, published, edition, isbn)
+		VALUES (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?)`,
+		"publishers/1/books/1", `[{"given_name":"Ann","family_name":"Lee"}]`, 10, true, 1, `["0-13-110362-8"]`,
+		"publishers/1/books/2", `[{"given_name":"Bob"},{"given_name":"Cy"}]`, 20, false, 2, `[]`,
+	)
+	if err != nil {
+		t.Fatalf("failed to insert test books: %v", err)
+	}
+
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{filter: `path in ["publishers/1/books/2", "publishers/1/books/3"]`, want: []string{"publishers/1/books/2"}},
+		{filter: `"0-13-110362-8" in isbn`, want: []string{"publishers/1/books/1"}},
+		{filter: `author.exists(a, a.given_name == "Cy")`, want: []string{"publishers/1/books/2"}},
+		{filter: `author.all(a, has(a.family_name))`, want: []string{"publishers/1/books/1"}},
+		{filter: `isbn.all(i, i.startsWith("1"))`, want: []string{"publishers/1/books/2"}},
+		{filter: `author.exists(a, a.given_name.startsWith("A")) && price > 5`, want: []string{"publishers/1/books/1"}},
+		{filter: `double(price) / 8.0 > 1.5 && string(edition) == "2"`, want: []string{"publishers/1/books/2"}},
+		{filter: `uint(edition) == 2u`, want: []string{"publishers/1/books/2"}},
+	}
+	for _, tt := range tests {
+		condition, args, err := convertCELToSQL("book", tt.filter)
+		if err != nil {
+			t.Fatalf("convertCELToSQL(%q) = %v", tt.filter, err)
+		}
+		got := selectPaths(t, db, "books", condition, args)
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("books matching %q = %v, want %v", tt.filter, got, tt.want)
+		}
+	}
+}
+
+func TestCELToSQLOnTimestamps(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	// the bookstore declares no time fields, so publishers are
+	// given a create time in the format of AEP-148.
+	_, err := db.Exec(`
+		ALTER TABLE publishers ADD COLUMN create_time TEXT;
+		INSERT INTO publishers (path, description, create_time)
+		VALUES ('publishers/1', 'a', '2023-12-31T23:30:00Z'),
+			('publishers/2', 'b', '2024-01-01T00:30:00.5+01:00'),
+			('publishers/3', 'c', '2024-03-02T10:00:00Z')`)
+	if err != nil {
+		t.Fatalf("failed to insert test publishers: %v", err)
+	}
+	d, err := bookstoreDefinition()
+	if err != nil {
+		t.Fatalf("failed to load the bookstore: %v", err)
+	}
+	fields, err := cel2ansisql.ResourceFields(d.API, "publisher")
+	if err != nil {
+		t.Fatalf("ResourceFields() = %v", err)
+	}
+	fields["create_time"] = cel2ansisql.Field{Type: cel.TimestampType, Column: "create_time"}
+
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{filter: `create_time >= timestamp("2024-01-01T00:00:00Z")`, want: []string{"publishers/3"}},
+		{filter: `create_time < timestamp("2024-01-01T00:00:00Z")`, want: []string{"publishers/1", "publishers/2"}},
+		{filter: `create_time + duration("30m") > timestamp("2024-01-01T00:00:00Z")`, want: []string{"publishers/2", "publishers/3"}},
+		{filter: `create_time.getFullYear() == 2023`, want: []string{"publishers/1", "publishers/2"}},
+		{filter: `create_time.getMonth() == 2 && create_time.getDate() == 2 && create_time.getDayOfWeek() == 6`, want: []string{"publishers/3"}},
+		{filter: `create_time.getMilliseconds() == 500 && create_time.getHours() == 23`, want: []string{"publishers/2"}},
+		{filter: `int(create_time) == 1709373600`, want: []string{"publishers/3"}},
+	}
+	for _, tt := range tests {
+		condition, args, err := convertFilter(fields, tt.filter)
+		if err != nil {
+			t.Fatalf("convertFilter(%q) = %v", tt.filter, err)
+		}
+		got := selectPaths(t, db, "publishers", condition, args)
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("publishers matching %q = %v, want %v", tt.filter, got, tt.want)
+		}
+	}
+}
+
+// selectPaths returns the paths of the rows of table that match
+// condition, in order.
+func selectPaths(t *testing.T, db *sql.DB, table, condition string, args []any) []string {
+	t.Helper()
+	rows, err := db.Query("SELECT path FROM "+table+" WHERE "+condition+" ORDER BY path", args...)
+	if err != nil {
+		t.Fatalf("query of %s failed: %v", condition, err)
+	}
+	defer rows.Close()
+	var paths []string
+	for rows.Next() {
+		var path string
+		if err := rows.Scan(&path); err != nil {
+			t.Fatalf("failed to scan path: %v", err)
+		}
+		paths = append(paths, path)
+	}
+	return paths
+}
@@ -522,9 +522,9 @@ func (s BookstoreServer) GetPublisher(ctx context.Context, r *bpb.GetPublisherRe
 
 func (s BookstoreServer) ListPublishers(_ context.Context, r *bpb.ListPublishersRequest) (*bpb.ListPublishersResponse, error) {
 	skip := r.GetSkip()
-	condition, err := convertCELToSQL(r.GetFilter())
+	condition, args, err := convertCELToSQL("publisher", r.GetFilter())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to convert filter: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
 	if condition != "" {
 		condition = "WHERE " + condition
@@ -534,7 +534,7 @@ func (s BookstoreServer) ListPublishers(_ context.Context, r *bpb.ListPublishers
 			SELECT path, description
 			FROM publishers
 			`+condition+`
-			LIMIT 10 OFFSET ?`, skip)
+			LIMIT 10 OFFSET ?`, append(args, skip)...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list publishers: %v", err)
 	}
@@ -666,6 +666,9 @@ func (s BookstoreServer) DeleteStore(_ context.Context, r *bpb.DeleteStoreReques
 func (s BookstoreServer) CreateItem(_ context.Context, r *bpb.CreateItemRequest) (*bpb.Item, error) {
 	item := proto.Clone(r.Item).(*bpb.Item)
 	log.Printf("creating item %q", r)
+	if err := validateItemCondition(item.Condition); err != nil {
+		return nil, err
+	}
 	if r.Id == "" {
 		var maxID int
 		err := s.db.QueryRow("SELECT COALESCE(MAX(CAST(SUBSTR(path, 14) AS INTEGER)), 0) FROM items").Scan(&maxID)
@@ -680,7 +683,7 @@ func (s BookstoreServer) CreateItem(_ context.Context, r *bpb.CreateItemRequest)
 	_, err := s.db.Exec(`
 		INSERT INTO items (path, book, condition, price)
 		VALUES (?, ?, ?, ?)`,
-		item.Path, item.Book, item.Condition, item.Price)
+		item.Path, item.Book, item.Condition.String(), item.Price)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create item: %v", err)
 	}
@@ -691,10 +694,11 @@ func (s BookstoreServer) CreateItem(_ context.Context, r *bpb.CreateItemRequest)
 
 func (s BookstoreServer) GetItem(_ context.Context, r *bpb.GetItemRequest) (*bpb.Item, error) {
 	item := &bpb.Item{}
+	var condition string
 	err := s.db.QueryRow(`
 		SELECT path, book, condition, price
 		FROM items WHERE path = ?`, r.Path).Scan(
-		&item.Path, &item.Book, &item.Condition, &item.Price)
+		&item.Path, &item.Book, &condition, &item.Price)
 
 	if err == sql.ErrNoRows {
 		return nil, status.Errorf(codes.NotFound, "item %q not found", r.Path)
@@ -702,6 +706,7 @@ func (s BookstoreServer) GetItem(_ context.Context, r *bpb.GetItemRequest) (*bpb
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get item: %v", err)
 	}
+	item.Condition = bpb.Item_Condition(bpb.Item_Condition_value[condition])
 	return item, nil
 }
 
@@ -731,12 +736,15 @@ func (s BookstoreServer) UpdateItem(ctx context.Context, r *bpb.UpdateItemReques
 
 	item := proto.Clone(r.Item).(*bpb.Item)
 	item.Path = r.Path
+	if err := validateItemCondition(item.Condition); err != nil {
+		return nil, err
+	}
 
 	result, err := s.db.Exec(`
 		UPDATE items
 		SET book = ?, condition = ?, price = ?
 		WHERE path = ?`,
-		item.Book, item.Condition, item.Price, item.Path)
+		item.Book, item.Condition.String(), item.Price, item.Path)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update item: %v", err)
 	}
@@ -753,6 +761,18 @@ func (s BookstoreServer) UpdateItem(ctx context.Context, r *bpb.UpdateItemReques
 	return item, nil
 }
 
+// validateItemCondition rejects conditions that are unset, or
+// not one of the values declared by the Condition enum.
+func validateItemCondition(c bpb.Item_Condition) error {
+	if c == bpb.Item_CONDITION_UNSPECIFIED {
+		return status.Errorf(codes.InvalidArgument, "item condition must be set")
+	}
+	if _, ok := bpb.Item_Condition_name[int32(c)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown item condition %d", c)
+	}
+	return nil
+}
+
 func (s BookstoreServer) DeleteItem(_ context.Context, r *bpb.DeleteItemRequest) (*emptypb.Empty, error) {
 	result, err := s.db.Exec("DELETE FROM items WHERE path = ?", r.Path)
 	if err != nil {
@@ -138,7 +138,7 @@ func TestCreateItem(t *testing.T) {
 		Parent: "stores/1",
 		Item: &bpb.Item{
 			Book:      "publishers/1/books/1",
-			Condition: "New",
+			Condition: bpb.Item_NEW,
 			Price:     29.99,
 		},
 	}
@@ -148,8 +148,40 @@ func TestCreateItem(t *testing.T) {
 		t.Fatalf("CreateItem failed: %v", err)
 	}
 
-	if item.Condition != "New" {
-		t.Fatalf("expected item condition to be 'New', got %q", item.Condition)
+	if item.Condition != bpb.Item_NEW {
+		t.Fatalf("expected item condition to be NEW, got %v", item.Condition)
+	}
+
+	got, err := s.GetItem(context.Background(), &bpb.GetItemRequest{Path: item.Path})
+	if err != nil {
+		t.Fatalf("GetItem failed: %v", err)
+	}
+	if got.Condition != bpb.Item_NEW {
+		t.Fatalf("expected stored item condition to be NEW, got %v", got.Condition)
+	}
+}
+
+func TestItemConditionValidation(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	s := NewBookstoreServer(db)
+
+	for _, condition := range []bpb.Item_Condition{bpb.Item_CONDITION_UNSPECIFIED, bpb.Item_Condition(42)} {
+		_, err := s.CreateItem(context.Background(), &bpb.CreateItemRequest{
+			Parent: "stores/1",
+			Item:   &bpb.Item{Book: "publishers/1/books/1", Condition: condition, Price: 29.99},
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("CreateItem with condition %v: expected InvalidArgument, got %v", condition, err)
+		}
+		_, err = s.UpdateItem(context.Background(), &bpb.UpdateItemRequest{
+			Path: "stores/1/items/1",
+			Item: &bpb.Item{Book: "publishers/1/books/1", Condition: condition, Price: 29.99},
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("UpdateItem with condition %v: expected InvalidArgument, got %v", condition, err)
+		}
 	}
 }
 
@@ -163,7 +195,7 @@ func TestMoveItem(t *testing.T) {
 	_, err := db.Exec(`
 		INSERT INTO items (path, book, condition, price)
 		VALUES (?, ?, ?, ?)
-	`, "stores/1/items/1", "publishers/1/books/1", "New", 29.99)
+	`, "stores/1/items/1", "publishers/1/books/1", "NEW", 29.99)
 	if err != nil {
 		t.Fatalf("failed to insert test item: %v", err)
 	}
@@ -531,3 +563,50 @@ func TestExtractIfMatchHeader(t *testing.T) {
 		t.Errorf("Expected empty ETag, got %s", extractedETag4)
 	}
 }
+
+func TestListPublishersWithFilter(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	s := NewBookstoreServer(db)
+
+	_, err := db.Exec(`
+		INSERT INTO publishers (path, description)
+		VALUES (?, ?), (?, ?)`,
+		"publishers/1", "tomorrow's books",
+		"publishers/2", "yesterday's books",
+	)
+	if err != nil {
+		t.Fatalf("failed to insert test publishers: %v", err)
+	}
+
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{filter: `description.startsWith("tomorrow's")`, want: []string{"publishers/1"}},
+		{filter: `description == "yesterday's books" || path == "publishers/1"`, want: []string{"publishers/1", "publishers/2"}},
+		{filter: `path == "publishers/1' OR '1'='1"`, want: nil},
+		{filter: `description.startsWith("Tomorrow")`, want: nil},
+		{filter: `description.contains("*")`, want: nil},
+		{filter: `description.endsWith("'s books")`, want: []string{"publishers/1", "publishers/2"}},
+	}
+	for _, tt := range tests {
+		resp, err := s.ListPublishers(context.Background(), &bpb.ListPublishersRequest{Filter: tt.filter})
+		if err != nil {
+			t.Fatalf("ListPublishers(%q) failed: %v", tt.filter, err)
+		}
+		var got []string
+		for _, p := range resp.Results {
+			got = append(got, p.Path)
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("ListPublishers(%q) = %v, want %v", tt.filter, got, tt.want)
+		}
+	}
+
+	_, err = s.ListPublishers(context.Background(), &bpb.ListPublishersRequest{Filter: "edition == 1"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListPublishers() with an unknown field = %v, want InvalidArgument", err)
+	}
+}
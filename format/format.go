@@ -0,0 +1,373 @@
+// Package format rewrites resource definitions in a canonical
+// form: declarations in a fixed key order, resources and shared
+// schemas sorted by name, properties sorted by field number,
+// and consistent quoting and indentation. Comments in YAML
+// definitions are preserved.
+package format
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Options configure Definition.
+type Options struct {
+	// FieldNumbers maps the JSON pointer of properties to the
+	// number to set as their x-aep-field.field_number.
+	FieldNumbers map[string]int
+}
+
+// The canonical order of the keys of each kind of declaration.
+// Unknown keys follow the known ones in name order.
+var (
+	apiKeys      = []string{"name", "version", "server_url", "contact", "imports", "x-aep-lint", "schemas", "resources"}
+	contactKeys  = []string{"name", "email", "url"}
+	resourceKeys = []string{"singular", "plural", "parents", "schema", "methods", "custom_methods"}
+	methodKeys   = []string{"create", "get", "update", "delete", "list", "apply"}
+	customKeys   = []string{"name", "method", "description", "is_long_running", "request", "response"}
+	schemaKeys   = []string{
+		"$ref", "type", "format", "description", "readOnly", "enum",
+		"required", "items", "properties", "x-aep-field", "x-aep-proto-message-name",
+	}
+)
+
+// Definition returns the canonical form of the definition b, in
+// the format given by ext (".yaml" or ".json").
+func Definition(ext string, b []byte, opts Options) ([]byte, error) {
+	if ext != ".yaml" && ext != ".json" {
+		return nil, fmt.Errorf("extension %v is unsupported", ext)
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("unable to decode %s: %w", ext[1:], err)
+	}
+	if len(doc.Content) == 0 {
+		return nil, fmt.Errorf("empty definition")
+	}
+	root := doc.Content[0]
+	for _, pointer := range sortedKeys(opts.FieldNumbers) {
+		if err := setFieldNumber(root, pointer, opts.FieldNumbers[pointer]); err != nil {
+			return nil, err
+		}
+	}
+	formatAPI(root)
+	if ext == ".json" {
+		var out bytes.Buffer
+		if err := writeJSON(&out, root, ""); err != nil {
+			return nil, err
+		}
+		out.WriteByte('\n')
+		return out.Bytes(), nil
+	}
+	normalizeStyle(&doc)
+	var out bytes.Buffer
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+func formatAPI(n *yaml.Node) {
+	orderKeys(n, apiKeys)
+	eachValue(n, func(key string, v *yaml.Node) {
+		switch key {
+		case "contact":
+			orderKeys(v, contactKeys)
+		case "schemas":
+			orderKeys(v, nil)
+			eachValue(v, func(_ string, s *yaml.Node) { formatSchema(s) })
+		case "resources":
+			orderKeys(v, nil)
+			eachValue(v, func(_ string, r *yaml.Node) { formatResource(r) })
+		}
+	})
+}
+
+func formatResource(n *yaml.Node) {
+	orderKeys(n, resourceKeys)
+	eachValue(n, func(key string, v *yaml.Node) {
+		switch key {
+		case "schema":
+			formatSchema(v)
+		case "methods":
+			orderKeys(v, methodKeys)
+			eachValue(v, func(_ string, m *yaml.Node) { orderKeys(m, nil) })
+		case "custom_methods":
+			if v.Kind != yaml.SequenceNode {
+				return
+			}
+			for _, cm := range v.Content {
+				orderKeys(cm, customKeys)
+				eachValue(cm, func(key string, s *yaml.Node) {
+					if key == "request" || key == "response" {
+						formatSchema(s)
+					}
+				})
+			}
+		}
+	})
+}
+
+func formatSchema(n *yaml.Node) {
+	orderKeys(n, schemaKeys)
+	eachValue(n, func(key string, v *yaml.Node) {
+		switch key {
+		case "items":
+			formatSchema(v)
+		case "properties":
+			orderProperties(v)
+			eachValue(v, func(_ string, p *yaml.Node) { formatSchema(p) })
+		case "x-aep-field":
+			orderKeys(v, nil)
+		}
+	})
+}
+
+// orderKeys sorts the entries of the mapping n: keys listed in
+// order first, in that order, then the others by name. The
+// comments of each entry move with it.
+func orderKeys(n *yaml.Node, order []string) {
+	rank := map[string]int{}
+	for i, k := range order {
+		rank[k] = i
+	}
+	sortEntries(n, func(a, b *yaml.Node) bool {
+		ra, aKnown := rank[a.Value]
+		rb, bKnown := rank[b.Value]
+		switch {
+		case aKnown && bKnown:
+			return ra < rb
+		case aKnown != bKnown:
+			return aKnown
+		}
+		return a.Value < b.Value
+	})
+}
+
+// orderProperties sorts the properties of the mapping n by
+// field number, followed by the unnumbered ones by name.
+func orderProperties(n *yaml.Node) {
+	numbers := map[*yaml.Node]int{}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		numbers[n.Content[i]] = fieldNumber(n.Content[i+1])
+	}
+	sortEntries(n, func(a, b *yaml.Node) bool {
+		na, nb := numbers[a], numbers[b]
+		switch {
+		case na != 0 && nb != 0 && na != nb:
+			return na < nb
+		case (na != 0) != (nb != 0):
+			return na != 0
+		}
+		return a.Value < b.Value
+	})
+}
+
+func fieldNumber(property *yaml.Node) int {
+	field := value(property, "x-aep-field")
+	if field == nil {
+		return 0
+	}
+	number := value(field, "field_number")
+	if number == nil {
+		return 0
+	}
+	n, _ := strconv.Atoi(number.Value)
+	return n
+}
+
+// sortEntries stably sorts the key/value pairs of the mapping n
+// by key.
+func sortEntries(n *yaml.Node, less func(a, b *yaml.Node) bool) {
+	if n.Kind != yaml.MappingNode {
+		return
+	}
+	pairs := [][2]*yaml.Node{}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		pairs = append(pairs, [2]*yaml.Node{n.Content[i], n.Content[i+1]})
+	}
+	sort.SliceStable(pairs, func(i, j int) bool { return less(pairs[i][0], pairs[j][0]) })
+	n.Content = n.Content[:0]
+	for _, p := range pairs {
+		n.Content = append(n.Content, p[0], p[1])
+	}
+}
+
+// eachValue calls f with every entry of the mapping n.
+func eachValue(n *yaml.Node, f func(key string, v *yaml.Node)) {
+	if n.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		f(n.Content[i].Value, n.Content[i+1])
+	}
+}
+
+// value returns the value of key in the mapping n, or nil.
+func value(n *yaml.Node, key string) *yaml.Node {
+	if n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// normalizeStyle drops the quoting of scalars unless it is
+// required, and renders sequences of scalars inline and every
+// other collection as a block.
+func normalizeStyle(n *yaml.Node) {
+	switch n.Kind {
+	case yaml.ScalarNode:
+		n.Style = 0
+	case yaml.MappingNode:
+		n.Style = 0
+		// the line comment of a collection written inline
+		// belongs to its key once the collection is a block.
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k, v := n.Content[i], n.Content[i+1]
+			if v.Kind != yaml.ScalarNode && v.LineComment != "" && k.LineComment == "" {
+				k.LineComment, v.LineComment = v.LineComment, ""
+			}
+		}
+	case yaml.SequenceNode:
+		n.Style = yaml.FlowStyle
+		for _, c := range n.Content {
+			if c.Kind != yaml.ScalarNode {
+				n.Style = 0
+			}
+		}
+	}
+	for _, c := range n.Content {
+		normalizeStyle(c)
+	}
+}
+
+var pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// setFieldNumber sets x-aep-field.field_number on the property
+// at pointer.
+func setFieldNumber(root *yaml.Node, pointer string, number int) error {
+	n := root
+	for _, token := range strings.Split(pointer, "/")[1:] {
+		token = pointerUnescaper.Replace(token)
+		var next *yaml.Node
+		switch n.Kind {
+		case yaml.MappingNode:
+			next = value(n, token)
+		case yaml.SequenceNode:
+			if i, err := strconv.Atoi(token); err == nil && i >= 0 && i < len(n.Content) {
+				next = n.Content[i]
+			}
+		}
+		if next == nil {
+			return fmt.Errorf("unable to locate %s", pointer)
+		}
+		n = next
+	}
+	if n.Kind != yaml.MappingNode {
+		return fmt.Errorf("%s: expected object", pointer)
+	}
+	field := value(n, "x-aep-field")
+	if field == nil {
+		field = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		n.Content = append(n.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "x-aep-field"}, field)
+	}
+	if field.Kind != yaml.MappingNode {
+		return fmt.Errorf("%s/x-aep-field: expected object", pointer)
+	}
+	v := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.Itoa(number)}
+	if existing := value(field, "field_number"); existing != nil {
+		*existing = *v
+		return nil
+	}
+	field.Content = append(field.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "field_number"}, v)
+	return nil
+}
+
+// writeJSON writes n as indented JSON, keeping the order of
+// mappings and the representation of numbers.
+func writeJSON(b *bytes.Buffer, n *yaml.Node, indent string) error {
+	switch n.Kind {
+	case yaml.AliasNode:
+		return writeJSON(b, n.Alias, indent)
+	case yaml.MappingNode, yaml.SequenceNode:
+		open, close, step := "{", "}", 2
+		if n.Kind == yaml.SequenceNode {
+			open, close, step = "[", "]", 1
+		}
+		if len(n.Content) == 0 {
+			b.WriteString(open + close)
+			return nil
+		}
+		b.WriteString(open)
+		inner := indent + "  "
+		for i := 0; i < len(n.Content); i += step {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString("\n" + inner)
+			if step == 2 {
+				if err := writeJSONString(b, n.Content[i].Value); err != nil {
+					return err
+				}
+				b.WriteString(": ")
+			}
+			if err := writeJSON(b, n.Content[i+step-1], inner); err != nil {
+				return err
+			}
+		}
+		b.WriteString("\n" + indent + close)
+		return nil
+	case yaml.ScalarNode:
+		switch n.ShortTag() {
+		case "!!int", "!!float":
+			if json.Valid([]byte(n.Value)) {
+				b.WriteString(n.Value)
+				return nil
+			}
+		case "!!bool":
+			b.WriteString(strings.ToLower(n.Value))
+			return nil
+		case "!!null":
+			b.WriteString("null")
+			return nil
+		}
+		return writeJSONString(b, n.Value)
+	}
+	return fmt.Errorf("unsupported node at line %d", n.Line)
+}
+
+func writeJSONString(b *bytes.Buffer, s string) error {
+	enc := json.NewEncoder(b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return err
+	}
+	// Encode terminates the value with a newline.
+	b.Truncate(b.Len() - 1)
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
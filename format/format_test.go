@@ -0,0 +1,154 @@
+package format
+
+import (
+	"testing"
+)
+
+func TestDefinition(t *testing.T) {
+	tests := []struct {
+		name  string
+		ext   string
+		input string
+		opts  Options
+		want  string
+	}{
+		{
+			name: "keys ordered and quoting dropped",
+			ext:  ".yaml",
+			input: `resources:
+  book:
+    methods: {list: {}, "get": {}}
+    plural: "books"
+    singular: "book"
+name: "bookstore.example.com"
+`,
+			want: `name: bookstore.example.com
+resources:
+  book:
+    singular: book
+    plural: books
+    methods:
+      get: {}
+      list: {}
+`,
+		},
+		{
+			name: "resources sorted by name, comments kept, collections as blocks",
+			ext:  ".yaml",
+			input: `# the API
+name: x.example.com
+resources:
+  # shelves hold books
+  shelf: {singular: shelf, plural: shelves}
+  book: {singular: book, plural: books} # a book
+`,
+			want: `# the API
+name: x.example.com
+resources:
+  book: # a book
+    singular: book
+    plural: books
+  # shelves hold books
+  shelf:
+    singular: shelf
+    plural: shelves
+`,
+		},
+		{
+			name: "properties sorted by field number",
+			ext:  ".yaml",
+			input: `schemas:
+  money:
+    required: ["units", "currency"]
+    properties:
+      units:
+        x-aep-field: {field_number: 2}
+        type: integer
+      extra: {type: string}
+      currency:
+        type: string
+        x-aep-field: {field_number: 1}
+`,
+			want: `schemas:
+  money:
+    required: [units, currency]
+    properties:
+      currency:
+        type: string
+        x-aep-field:
+          field_number: 1
+      units:
+        type: integer
+        x-aep-field:
+          field_number: 2
+      extra:
+        type: string
+`,
+		},
+		{
+			name: "field numbers written",
+			ext:  ".yaml",
+			input: `schemas:
+  money:
+    properties:
+      units: {type: integer}
+`,
+			opts: Options{FieldNumbers: map[string]int{"/schemas/money/properties/units": 3}},
+			want: `schemas:
+  money:
+    properties:
+      units:
+        type: integer
+        x-aep-field:
+          field_number: 3
+`,
+		},
+		{
+			name:  "json",
+			ext:   ".json",
+			input: `{"resources": {"book": {"plural": "books", "singular": "book", "parents": ["shelf"]}}, "name": "x.example.com", "n": 1.50}`,
+			want: `{
+  "name": "x.example.com",
+  "resources": {
+    "book": {
+      "singular": "book",
+      "plural": "books",
+      "parents": [
+        "shelf"
+      ]
+    }
+  },
+  "n": 1.50
+}
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Definition(tt.ext, []byte(tt.input), tt.opts)
+			if err != nil {
+				t.Fatalf("Definition() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Definition() =\n%s\nwant\n%s", got, tt.want)
+			}
+			again, err := Definition(tt.ext, got, Options{})
+			if err != nil {
+				t.Fatalf("Definition() error = %v", err)
+			}
+			if string(again) != string(got) {
+				t.Errorf("Definition() is not idempotent, got\n%s", again)
+			}
+		})
+	}
+}
+
+func TestDefinitionErrors(t *testing.T) {
+	if _, err := Definition(".toml", []byte(`name = "x"`), Options{}); err == nil {
+		t.Errorf("Definition() error = nil for an unsupported extension")
+	}
+	_, err := Definition(".yaml", []byte("name: x\n"), Options{FieldNumbers: map[string]int{"/schemas/money/properties/units": 1}})
+	if err == nil {
+		t.Errorf("Definition() error = nil for a missing property")
+	}
+}
@@ -0,0 +1,36 @@
+package generator
+
+// BufFiles are the buf configuration files written to the root
+// of a tree generated with a Layout, keyed by name. They declare
+// the dependencies of the generated protos, and generate the Go,
+// gRPC and gRPC-gateway code next to each proto.
+var BufFiles = map[string]string{
+	"buf.yaml": `# Code generated by aepc. DO NOT EDIT.
+version: v2
+breaking:
+  use:
+    - FILE
+deps:
+  - buf.build/googleapis/googleapis
+  - buf.build/aep/api
+`,
+	"buf.gen.yaml": `# Code generated by aepc. DO NOT EDIT.
+version: v2
+managed:
+  enabled: true
+  override:
+    - module: buf.build/aep/api
+      file_option: go_package_prefix
+      value: buf.build/gen/go/aep/api/protocolbuffers/go
+plugins:
+  - remote: buf.build/protocolbuffers/go:v1.31.0
+    out: .
+    opt: paths=source_relative
+  - remote: buf.build/grpc-ecosystem/gateway:v2.18.0
+    out: .
+    opt: paths=source_relative
+  - remote: buf.build/grpc/go:v1.3.0
+    out: .
+    opt: paths=source_relative
+`,
+}
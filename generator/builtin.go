@@ -0,0 +1,7 @@
+package generator
+
+func init() {
+	Register(protoGenerator{})
+	Register(openAPIJSONGenerator{})
+	Register(openAPIYAMLGenerator{})
+}
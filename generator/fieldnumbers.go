@@ -0,0 +1,128 @@
+package generator
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/aep-dev/aep-lib-go/pkg/cases"
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+	"github.com/aep-dev/aepc/loader"
+	"github.com/aep-dev/aepc/lock"
+)
+
+// The field numbers reserved for the implementation of the
+// protocol buffers runtime.
+const (
+	firstReservedFieldNumber = 19000
+	lastReservedFieldNumber  = 19999
+)
+
+// Assignment is a field number assigned to a property that
+// omits x-aep-field.field_number.
+type Assignment struct {
+	// Pointer is the JSON pointer of the property.
+	Pointer string
+	Number  int
+}
+
+// AssignFieldNumbers numbers every property of d that generates
+// a proto field but omits x-aep-field.field_number, returning
+// the assignments ordered by pointer. A property keeps the
+// number recorded for its field in l, if any. The others are
+// numbered in name order with the lowest numbers not used,
+// locked or retired in their message, so that adding a property
+// never renumbers another once l is updated. l may be nil.
+func AssignFieldNumbers(d *loader.Definition, l *lock.Lock) []Assignment {
+	if l == nil {
+		l = lock.New()
+	}
+	as := &assigner{lock: l}
+	a := d.API
+	for _, name := range sortedNames(a.Schemas) {
+		as.assign("/schemas/"+pointerEscaper.Replace(name), toMessageName(name), a.Schemas[name])
+	}
+	for _, name := range sortedNames(a.Resources) {
+		r := a.Resources[name]
+		loc := "/resources/" + pointerEscaper.Replace(name)
+		as.assign(loc+"/schema", toMessageName(name), r.Schema)
+		for i, cm := range r.CustomMethods {
+			method := cases.KebabToCamelCase(cm.Name) + toMessageName(r.Singular)
+			cmLoc := loc + "/custom_methods/" + strconv.Itoa(i)
+			as.assign(cmLoc+"/request", method+"Request", cm.Request)
+			as.assign(cmLoc+"/response", method+"Response", cm.Response)
+		}
+	}
+	sort.Slice(as.assigned, func(i, j int) bool { return as.assigned[i].Pointer < as.assigned[j].Pointer })
+	return as.assigned
+}
+
+type assigner struct {
+	lock     *lock.Lock
+	assigned []Assignment
+}
+
+// assign numbers the properties of s, the schema of message
+// msg located at pointer, then those of its nested messages.
+func (as *assigner) assign(pointer, msg string, s *openapi.Schema) {
+	if s == nil || s.XAEPProtoMessageName != "" {
+		return
+	}
+	used := map[int]bool{}
+	missing := []string{}
+	for _, name := range sortedNames(s.Properties) {
+		if p := s.Properties[name]; p.XAEPField != nil && p.XAEPField.FieldNumber != 0 {
+			used[p.XAEPField.FieldNumber] = true
+		} else {
+			missing = append(missing, name)
+		}
+	}
+	locked := as.lock.Messages[msg]
+	if locked == nil {
+		locked = &lock.Message{}
+	}
+	for _, n := range locked.Fields {
+		used[int(n)] = true
+	}
+	for _, f := range locked.Retired {
+		used[int(f.Number)] = true
+	}
+	next := 1
+	for _, name := range missing {
+		number, ok := locked.Fields[name]
+		n := int(number)
+		if !ok {
+			for used[next] || (next >= firstReservedFieldNumber && next <= lastReservedFieldNumber) {
+				next++
+			}
+			n = next
+			used[n] = true
+		}
+		p := s.Properties[name]
+		if p.XAEPField == nil {
+			p.XAEPField = &openapi.XAEPField{}
+		}
+		p.XAEPField.FieldNumber = n
+		s.Properties[name] = p
+		as.assigned = append(as.assigned, Assignment{Pointer: pointer + "/properties/" + pointerEscaper.Replace(name), Number: n})
+	}
+	for _, name := range sortedNames(s.Properties) {
+		p := s.Properties[name]
+		nested := msg + "." + toMessageName(name)
+		loc := pointer + "/properties/" + pointerEscaper.Replace(name)
+		if p.Ref == "" && p.Type == "object" {
+			as.assign(loc, nested, &p)
+		}
+		if p.Items != nil && p.Items.Ref == "" && p.Items.Type == "object" {
+			as.assign(loc+"/items", nested, p.Items)
+		}
+	}
+}
+
+func sortedNames[V any](m map[string]V) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,78 @@
+package generator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aep-dev/aepc/loader"
+	"github.com/aep-dev/aepc/lock"
+)
+
+const unnumbered = `name: shop.example.com
+resources:
+  shelf:
+    singular: shelf
+    plural: shelves
+    schema:
+      properties:
+        theme: {type: string}
+        size: {type: integer, format: int32, x-aep-field: {field_number: 1}}
+        color: {type: string}
+        dims:
+          type: object
+          properties:
+            width: {type: number, format: double}
+    methods: {get: {}}
+`
+
+func TestAssignFieldNumbers(t *testing.T) {
+	tests := []struct {
+		name string
+		lock string
+		want []Assignment
+	}{
+		{
+			name: "no lock",
+			want: []Assignment{
+				{"/resources/shelf/schema/properties/color", 2},
+				{"/resources/shelf/schema/properties/dims", 3},
+				{"/resources/shelf/schema/properties/dims/properties/width", 1},
+				{"/resources/shelf/schema/properties/theme", 4},
+			},
+		},
+		{
+			name: "locked and retired numbers",
+			lock: `
+messages:
+  Shelf:
+    fields: {theme: 2, size: 1}
+    retired: [{name: label, number: 3}]
+`,
+			want: []Assignment{
+				{"/resources/shelf/schema/properties/color", 4},
+				{"/resources/shelf/schema/properties/dims", 5},
+				{"/resources/shelf/schema/properties/dims/properties/width", 1},
+				{"/resources/shelf/schema/properties/theme", 2},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := loader.Load("shop.yaml", []byte(unnumbered), loader.Options{})
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			l, err := lock.Parse([]byte(tt.lock))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			got := AssignFieldNumbers(d, l)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AssignFieldNumbers() = %v, want %v", got, tt.want)
+			}
+			if n := d.API.Resources["shelf"].Schema.Properties["color"].XAEPField.FieldNumber; n != tt.want[0].Number {
+				t.Errorf("color field number = %d, want %d", n, tt.want[0].Number)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Package generator exposes the outputs ("spokes") that can
+// be generated from a resource definition.
+//
+// Each output implements Generator and registers itself by
+// name, so new outputs can be added without modifying the
+// callers that drive generation.
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aepc/loader"
+	"github.com/aep-dev/aepc/lock"
+)
+
+// Options are passed to every generator.
+type Options struct {
+	// OutputDir is the directory the generated files will
+	// be written to.
+	OutputDir string
+	// Layout, if set, determines the proto package and
+	// go_package, rather than OutputDir.
+	Layout *Layout
+	// Lock, if set, holds the fields retired from each
+	// message, which the proto generator reserves.
+	Lock *lock.Lock
+}
+
+// Generator produces a single output from a definition.
+type Generator interface {
+	// Name is the target name used to select the generator,
+	// e.g. "proto".
+	Name() string
+	// Suffix is appended to the output prefix to build the
+	// name of the generated file, e.g. ".proto".
+	Suffix() string
+	// Generate returns the contents of the generated file.
+	Generate(d *loader.Definition, opts Options) ([]byte, error)
+}
+
+var (
+	mu         sync.RWMutex
+	generators = map[string]Generator{}
+)
+
+// DefaultTargets are the targets generated when none are
+// explicitly requested.
+var DefaultTargets = []string{"proto", "openapi-json", "openapi-yaml"}
+
+// Register makes a generator available by name. It panics if
+// a generator with the same name is already registered.
+func Register(g Generator) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := generators[g.Name()]; ok {
+		panic(fmt.Sprintf("generator %q registered twice", g.Name()))
+	}
+	generators[g.Name()] = g
+}
+
+// Get returns the generator registered for target.
+func Get(target string) (Generator, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	g, ok := generators[target]
+	if !ok {
+		return nil, fmt.Errorf("unknown target %q, must be one of %v", target, names())
+	}
+	return g, nil
+}
+
+// Names returns the sorted names of all registered generators.
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return names()
+}
+
+func names() []string {
+	n := []string{}
+	for name := range generators {
+		n = append(n, name)
+	}
+	sort.Strings(n)
+	return n
+}
+
+// Resolve returns the generators for targets, in order. An
+// empty list of targets resolves to DefaultTargets.
+func Resolve(targets []string) ([]Generator, error) {
+	if len(targets) == 0 {
+		targets = DefaultTargets
+	}
+	gens := []Generator{}
+	seen := map[string]bool{}
+	for _, t := range targets {
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		g, err := Get(t)
+		if err != nil {
+			return nil, err
+		}
+		gens = append(gens, g)
+	}
+	return gens, nil
+}
+
+// Run executes g against a copy of the API of d, so that
+// generators which modify the API in place do not affect each
+// other.
+func Run(g Generator, d *loader.Definition, opts Options) ([]byte, error) {
+	c, err := cloneAPI(d.API)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy api for target %q: %w", g.Name(), err)
+	}
+	clone := *d
+	clone.API = c
+	out, err := g.Generate(&clone, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error generating target %q: %w", g.Name(), err)
+	}
+	return out, nil
+}
+
+// cloneAPI returns a deep copy of a by round-tripping it
+// through its serialized form.
+func cloneAPI(a *api.API) (*api.API, error) {
+	b, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	c, err := api.LoadAPIFromJson(b)
+	if err != nil {
+		return nil, err
+	}
+	// resolve the resource patterns up front, so every generator
+	// sees the same patterns regardless of which ran first.
+	for _, r := range c.Resources {
+		r.PatternElems()
+	}
+	return c, nil
+}
@@ -0,0 +1,58 @@
+package generator
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Layout places the outputs of an API following the protobuf
+// conventions: the proto package is the API name with its
+// domain reversed, and the directory of the outputs matches
+// the package.
+type Layout struct {
+	// Package is the proto package, e.g. com.example.bookstore.v1.
+	Package string
+	// Dir is the slash-separated directory of the outputs,
+	// relative to the root of the tree, e.g.
+	// com/example/bookstore/v1.
+	Dir string
+	// Base is the name of the outputs, to which the suffix of
+	// each generator is appended, e.g. bookstore.
+	Base string
+	// GoPackage is the go_package option of the proto, e.g.
+	// com/example/bookstore/v1;bookstorev1.
+	GoPackage string
+}
+
+var packagePart = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// NewLayout returns the layout of the API named name, such as
+// bookstore.example.com, at version, such as v1. version may be
+// empty. The go_package is rooted at goPackagePrefix, the Go
+// module path of the tree, if set.
+func NewLayout(name, version, goPackagePrefix string) (Layout, error) {
+	parts := strings.Split(strings.ToLower(name), ".")
+	slices.Reverse(parts)
+	if version != "" {
+		parts = append(parts, version)
+	}
+	for i, p := range parts {
+		parts[i] = strings.ReplaceAll(p, "-", "_")
+		if !packagePart.MatchString(parts[i]) {
+			return Layout{}, fmt.Errorf("unable to derive a proto package from api name %q and version %q: %q is not a valid package name", name, version, p)
+		}
+	}
+	l := Layout{
+		Package: strings.Join(parts, "."),
+		Dir:     path.Join(parts...),
+		Base:    parts[len(parts)-1],
+	}
+	if version != "" {
+		l.Base = parts[len(parts)-2]
+	}
+	l.GoPackage = fmt.Sprintf("%s;%s%s", path.Join(goPackagePrefix, l.Dir), strings.ReplaceAll(l.Base, "_", ""), version)
+	return l, nil
+}
@@ -0,0 +1,95 @@
+package generator
+
+import (
+	"testing"
+)
+
+func TestNewLayout(t *testing.T) {
+	tests := []struct {
+		name, apiName, version, prefix string
+		want                           Layout
+		wantErr                        bool
+	}{
+		{
+			name:    "versioned",
+			apiName: "bookstore.example.com",
+			version: "v1",
+			want: Layout{
+				Package:   "com.example.bookstore.v1",
+				Dir:       "com/example/bookstore/v1",
+				Base:      "bookstore",
+				GoPackage: "com/example/bookstore/v1;bookstorev1",
+			},
+		},
+		{
+			name:    "unversioned",
+			apiName: "bookstore.example.com",
+			want: Layout{
+				Package:   "com.example.bookstore",
+				Dir:       "com/example/bookstore",
+				Base:      "bookstore",
+				GoPackage: "com/example/bookstore;bookstore",
+			},
+		},
+		{
+			name:    "go package prefix",
+			apiName: "bookstore.example.com",
+			version: "v1alpha1",
+			prefix:  "github.com/acme/apis",
+			want: Layout{
+				Package:   "com.example.bookstore.v1alpha1",
+				Dir:       "com/example/bookstore/v1alpha1",
+				Base:      "bookstore",
+				GoPackage: "github.com/acme/apis/com/example/bookstore/v1alpha1;bookstorev1alpha1",
+			},
+		},
+		{
+			name:    "dashes and case",
+			apiName: "Book-Store.example.com",
+			want: Layout{
+				Package:   "com.example.book_store",
+				Dir:       "com/example/book_store",
+				Base:      "book_store",
+				GoPackage: "com/example/book_store;bookstore",
+			},
+		},
+		{
+			name:    "single label",
+			apiName: "bookstore",
+			version: "v2",
+			want: Layout{
+				Package:   "bookstore.v2",
+				Dir:       "bookstore/v2",
+				Base:      "bookstore",
+				GoPackage: "bookstore/v2;bookstorev2",
+			},
+		},
+		{
+			name:    "label starting with a digit",
+			apiName: "bookstore.1example.com",
+			wantErr: true,
+		},
+		{
+			name:    "empty label",
+			apiName: "bookstore..com",
+			wantErr: true,
+		},
+		{
+			name:    "invalid version",
+			apiName: "bookstore.example.com",
+			version: "1.0",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewLayout(tt.apiName, tt.version, tt.prefix)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewLayout() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NewLayout() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
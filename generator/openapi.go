@@ -0,0 +1,90 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/aep-dev/aepc/loader"
+	"github.com/ghodss/yaml"
+)
+
+// openAPIJSONGenerator generates the OpenAPI definition as JSON.
+type openAPIJSONGenerator struct{}
+
+func (openAPIJSONGenerator) Name() string   { return "openapi-json" }
+func (openAPIJSONGenerator) Suffix() string { return "_openapi.json" }
+
+func (openAPIJSONGenerator) Generate(d *loader.Definition, _ Options) ([]byte, error) {
+	return openAPIBytes(d)
+}
+
+// openAPIYAMLGenerator generates the OpenAPI definition as YAML.
+type openAPIYAMLGenerator struct{}
+
+func (openAPIYAMLGenerator) Name() string   { return "openapi-yaml" }
+func (openAPIYAMLGenerator) Suffix() string { return "_openapi.yaml" }
+
+func (openAPIYAMLGenerator) Generate(d *loader.Definition, _ Options) ([]byte, error) {
+	openapi, err := openAPIBytes(d)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.JSONToYAML(openapi)
+}
+
+// openAPIBytes returns the OpenAPI definition of d as JSON,
+// with the enums of d added to the component schemas, and the
+// version of d set and prefixed to every path.
+func openAPIBytes(d *loader.Definition) ([]byte, error) {
+	b, err := d.API.ConvertToOpenAPIBytes()
+	if err != nil || (len(d.Enums) == 0 && d.Version == "") {
+		return b, err
+	}
+	doc, err := decodeOrderedJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	if d.Version != "" {
+		if err := setOpenAPIVersion(doc, d.Version); err != nil {
+			return nil, err
+		}
+	}
+	for _, e := range d.Enums {
+		component := e.Schema
+		if e.Resource {
+			component = d.API.Resources[e.Schema].Singular
+		}
+		pointer := "/components/schemas/" + pointerEscaper.Replace(component) + e.SchemaPointer()
+		s, ok := lookupOrderedJSON(doc, pointer).(*orderedObject)
+		if !ok {
+			return nil, fmt.Errorf("unable to find the schema of enum %s in the OpenAPI definition", e.Pointer)
+		}
+		values := []any{}
+		for _, v := range e.Values {
+			values = append(values, v)
+		}
+		s.insertAfter("enum", values, "format", "type")
+	}
+	return encodeOrderedJSON(doc), nil
+}
+
+// setOpenAPIVersion sets the info.version of doc to version,
+// and prefixes each of its paths with it, e.g. /publishers
+// becomes /v1/publishers.
+func setOpenAPIVersion(doc any, version string) error {
+	info, ok := lookupOrderedJSON(doc, "/info").(*orderedObject)
+	if !ok {
+		return fmt.Errorf("unable to find the info of the OpenAPI definition")
+	}
+	info.insertAfter("version", version, "description", "title")
+	paths, ok := lookupOrderedJSON(doc, "/paths").(*orderedObject)
+	if !ok {
+		return nil
+	}
+	prefixed := &orderedObject{values: map[string]any{}}
+	for _, path := range paths.keys {
+		prefixed.keys = append(prefixed.keys, "/"+version+path)
+		prefixed.values["/"+version+path] = paths.values[path]
+	}
+	*paths = *prefixed
+	return nil
+}
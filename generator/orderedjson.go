@@ -0,0 +1,164 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// orderedObject is a JSON object that preserves the order of
+// its keys, so a document can be modified without reordering
+// it.
+type orderedObject struct {
+	keys   []string
+	values map[string]any
+}
+
+// insertAfter sets key to v, placing it after the first of
+// previous present in o, or first if none are.
+func (o *orderedObject) insertAfter(key string, v any, previous ...string) {
+	if _, ok := o.values[key]; ok {
+		o.values[key] = v
+		return
+	}
+	i := 0
+	for _, p := range previous {
+		if j := slices.Index(o.keys, p); j >= 0 {
+			i = j + 1
+			break
+		}
+	}
+	o.keys = append(o.keys[:i], append([]string{key}, o.keys[i:]...)...)
+	o.values[key] = v
+}
+
+var (
+	pointerEscaper   = strings.NewReplacer("~", "~0", "/", "~1")
+	pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+)
+
+// decodeOrderedJSON decodes b into generic values, decoding
+// objects as *orderedObject and numbers as json.Number.
+func decodeOrderedJSON(b []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	return decodeOrderedValue(dec)
+}
+
+func decodeOrderedValue(dec *json.Decoder) (any, error) {
+	t, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	delim, ok := t.(json.Delim)
+	if !ok {
+		return t, nil
+	}
+	switch delim {
+	case '{':
+		o := &orderedObject{values: map[string]any{}}
+		for dec.More() {
+			k, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			v, err := decodeOrderedValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			o.keys = append(o.keys, k.(string))
+			o.values[k.(string)] = v
+		}
+		_, err = dec.Token()
+		return o, err
+	case '[':
+		l := []any{}
+		for dec.More() {
+			v, err := decodeOrderedValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			l = append(l, v)
+		}
+		_, err = dec.Token()
+		return l, err
+	}
+	return nil, fmt.Errorf("unexpected delimiter %v", delim)
+}
+
+// lookupOrderedJSON returns the value at pointer in v, or nil
+// if there is none.
+func lookupOrderedJSON(v any, pointer string) any {
+	if pointer == "" {
+		return v
+	}
+	for _, token := range strings.Split(pointer, "/")[1:] {
+		token = pointerUnescaper.Replace(token)
+		switch c := v.(type) {
+		case *orderedObject:
+			v = c.values[token]
+		case []any:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(c) {
+				return nil
+			}
+			v = c[i]
+		default:
+			return nil
+		}
+	}
+	return v
+}
+
+// encodeOrderedJSON encodes v with the same indentation as
+// json.MarshalIndent(v, "", "  ").
+func encodeOrderedJSON(v any) []byte {
+	var b bytes.Buffer
+	writeOrderedValue(&b, v, "")
+	return b.Bytes()
+}
+
+func writeOrderedValue(b *bytes.Buffer, v any, indent string) {
+	switch c := v.(type) {
+	case *orderedObject:
+		if len(c.keys) == 0 {
+			b.WriteString("{}")
+			return
+		}
+		b.WriteString("{\n")
+		for i, k := range c.keys {
+			b.WriteString(indent + "  ")
+			writeOrderedValue(b, k, "")
+			b.WriteString(": ")
+			writeOrderedValue(b, c.values[k], indent+"  ")
+			if i < len(c.keys)-1 {
+				b.WriteString(",")
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString(indent + "}")
+	case []any:
+		if len(c) == 0 {
+			b.WriteString("[]")
+			return
+		}
+		b.WriteString("[\n")
+		for i, e := range c {
+			b.WriteString(indent + "  ")
+			writeOrderedValue(b, e, indent+"  ")
+			if i < len(c)-1 {
+				b.WriteString(",")
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString(indent + "]")
+	default:
+		// strings, numbers, booleans and null encode the same
+		// as with encoding/json.
+		out, _ := json.Marshal(c)
+		b.Write(out)
+	}
+}
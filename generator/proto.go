@@ -0,0 +1,375 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aep-lib-go/pkg/cases"
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+	"github.com/aep-dev/aep-lib-go/pkg/proto"
+	"github.com/aep-dev/aepc/loader"
+	"github.com/aep-dev/aepc/lock"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/builder"
+	"github.com/jhump/protoreflect/desc/protoprint"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// protoGenerator generates the service proto.
+type protoGenerator struct{}
+
+func (protoGenerator) Name() string   { return "proto" }
+func (protoGenerator) Suffix() string { return ".proto" }
+
+func (protoGenerator) Generate(d *loader.Definition, opts Options) ([]byte, error) {
+	fd, err := apiToProto(d, opts)
+	if err != nil {
+		return nil, err
+	}
+	printer := protoprint.Printer{
+		CustomSortFunction: compareProtoElements,
+	}
+	var output bytes.Buffer
+	err = printer.PrintProtoFile(fd, &output)
+	if err != nil {
+		return nil, err
+	}
+	return output.Bytes(), nil
+}
+
+// apiToProto builds the file descriptor for d. It follows
+// proto.APIToProto, but generates the shared schemas in
+// dependency order so resources can reference them, and
+// generates the enums of d and the reserved fields of
+// opts.Lock.
+func apiToProto(d *loader.Definition, opts Options) (*desc.FileDescriptor, error) {
+	a := d.API
+	m := &proto.MessageStorage{Messages: map[string]proto.Message{}}
+	fb := builder.NewFile("test.proto")
+	fb.Package = protoPackage(opts.OutputDir, d.Version)
+	if opts.Layout == nil && fb.Package == "" {
+		// without a directory to derive it from, such as when
+		// writing to the working directory or to stdout, the
+		// package and go_package follow the layout of the API.
+		// The directory would otherwise give the package ".",
+		// which does not build, so no proto that generated
+		// before changes.
+		l, err := NewLayout(a.Name, d.Version, "")
+		if err != nil {
+			return nil, err
+		}
+		opts.Layout = &l
+	}
+	if opts.Layout != nil {
+		fb.Package = opts.Layout.Package
+	}
+	fb.IsProto3 = true
+	// As a file comment is not printed by protoprint,
+	// use package comments instead.
+	fb.SetPackageComments(builder.Comments{
+		LeadingComment: "this file is generated.",
+	})
+	pServiceName := toProtoServiceName(a.Name)
+	goPackage := fmt.Sprintf("/%s", strings.ToLower(pServiceName))
+	if opts.Layout != nil {
+		goPackage = opts.Layout.GoPackage
+	}
+	fb.SetOptions(&descriptorpb.FileOptions{
+		GoPackage: &goPackage,
+	})
+	sb := builder.NewService(pServiceName)
+	sb.SetComments(builder.Comments{
+		LeadingComment: "A service.",
+	})
+
+	err := generateSchemaMessages(a, m, fb)
+	if err != nil {
+		return nil, err
+	}
+	err = addEnums(d.Enums, fb)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range sortedResources(a) {
+		err := proto.AddResource(r, a, fb, sb, m)
+		if err != nil {
+			return nil, fmt.Errorf("adding resource %v failed: %w", r.Singular, err)
+		}
+	}
+	if d.Version != "" {
+		prefixHTTPRules(sb, "/"+d.Version)
+	}
+	if opts.Lock != nil {
+		addReserved(opts.Lock, fb)
+	}
+	fb.AddService(sb)
+	fd, err := fb.Build()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build service file %v: %w", fb.GetName(), err)
+	}
+
+	// protoreflect sometimes adds "import {generated-file-0001}.proto" unnecessarily.
+	deps := []string{}
+	for _, v := range fd.AsFileDescriptorProto().Dependency {
+		if !strings.Contains(v, "generated-file") {
+			deps = append(deps, v)
+		}
+	}
+	fd.AsFileDescriptorProto().Dependency = deps
+	return fd, nil
+}
+
+// generateSchemaMessages adds a message for every shared
+// schema and resource, each after the schemas it references.
+func generateSchemaMessages(a *api.API, m *proto.MessageStorage, fb *builder.FileBuilder) error {
+	order, err := loader.SchemaOrder(a)
+	if err != nil {
+		return err
+	}
+	schemas := loader.NamedSchemas(a)
+	for _, name := range order {
+		s := schemas[name]
+		setRefTypes(s)
+		msg, err := proto.GenerateSchemaMessage(name, s, a, m)
+		if err != nil {
+			return err
+		}
+		msg.AddMessage(fb)
+	}
+	return nil
+}
+
+// addEnums declares each enum as a nested enum of the message
+// holding its property, and sets the type of the property's
+// field to it. The first value of the enum is its unspecified
+// value, followed by the declared values.
+func addEnums(enums []loader.Enum, fb *builder.FileBuilder) error {
+	for _, e := range enums {
+		mb := fb.GetMessage(toMessageName(e.Schema))
+		for _, name := range e.Path[:len(e.Path)-1] {
+			if mb == nil {
+				break
+			}
+			mb = mb.GetNestedMessage(toMessageName(name))
+		}
+		if mb == nil {
+			return fmt.Errorf("unable to find the message holding enum %s", e.Pointer)
+		}
+		f := mb.GetField(e.Property())
+		if f == nil {
+			return fmt.Errorf("unable to find the field for enum %s", e.Pointer)
+		}
+		eb := builder.NewEnum(toMessageName(e.Property()))
+		eb.SetComments(builder.Comments{
+			LeadingComment: fmt.Sprintf("Possible values for %v.", e.Property()),
+		})
+		values := append([]string{e.UnspecifiedValue()}, e.Values...)
+		for i, v := range values {
+			eb.AddValue(builder.NewEnumValue(v).SetNumber(int32(i)))
+		}
+		if err := mb.TryAddNestedEnum(eb); err != nil {
+			return fmt.Errorf("unable to add enum %s: %w", e.Pointer, err)
+		}
+		f.SetType(builder.FieldTypeEnum(eb))
+	}
+	return nil
+}
+
+// addReserved reserves the names and numbers of the fields
+// retired from each message.
+func addReserved(l *lock.Lock, fb *builder.FileBuilder) {
+	for name, m := range l.Messages {
+		retired := m.Retired
+		if len(retired) == 0 {
+			continue
+		}
+		path := strings.Split(name, ".")
+		mb := fb.GetMessage(path[0])
+		for _, nested := range path[1:] {
+			if mb == nil {
+				break
+			}
+			mb = mb.GetNestedMessage(nested)
+		}
+		if mb == nil {
+			continue
+		}
+		for _, r := range lock.Ranges(retired) {
+			mb.AddReservedRange(r[0], r[1])
+		}
+		for _, f := range retired {
+			mb.AddReservedName(f.Name)
+		}
+	}
+}
+
+// ProtoMessages returns the fields of each message of the
+// proto generated for d, keyed by message name relative to the
+// package, e.g. "Item.Details", then by field name.
+func ProtoMessages(d *loader.Definition, opts Options) (map[string]map[string]int32, error) {
+	c, err := cloneAPI(d.API)
+	if err != nil {
+		return nil, err
+	}
+	clone := *d
+	clone.API = c
+	opts.Lock = nil
+	fd, err := apiToProto(&clone, opts)
+	if err != nil {
+		return nil, err
+	}
+	messages := map[string]map[string]int32{}
+	var add func(prefix string, md *desc.MessageDescriptor)
+	add = func(prefix string, md *desc.MessageDescriptor) {
+		name := prefix + md.GetName()
+		fields := map[string]int32{}
+		for _, f := range md.GetFields() {
+			fields[f.GetName()] = f.GetNumber()
+		}
+		messages[name] = fields
+		for _, nested := range md.GetNestedMessageTypes() {
+			if !nested.IsMapEntry() {
+				add(name+".", nested)
+			}
+		}
+	}
+	for _, md := range fd.GetMessageTypes() {
+		add("", md)
+	}
+	return messages, nil
+}
+
+// setRefTypes sets the type of properties that only declare a
+// reference to "object", which the proto generator requires
+// to resolve the reference.
+func setRefTypes(s *openapi.Schema) {
+	if s == nil {
+		return
+	}
+	if s.Ref != "" && s.Type == "" {
+		s.Type = "object"
+	}
+	for name, p := range s.Properties {
+		setRefTypes(&p)
+		s.Properties[name] = p
+	}
+	setRefTypes(s.Items)
+}
+
+// protoPackage derives the proto package from the directory
+// the proto is written to, e.g. example/bookstore/v1 becomes
+// example.bookstore.v1. version is appended unless the
+// directory already ends with it. It is empty for the working
+// directory.
+func protoPackage(outputDir, version string) string {
+	if outputDir == "" || outputDir == "." {
+		return ""
+	}
+	dir, file := filepath.Split(outputDir)
+	packageParts := []string{file}
+	for dir != "." && dir != "" && dir != string(filepath.Separator) {
+		dir = filepath.Clean(dir)
+		dir, file = filepath.Split(dir)
+		dir = filepath.Clean(dir)
+		packageParts = append(packageParts, file)
+	}
+	slices.Reverse(packageParts)
+	if version != "" && packageParts[len(packageParts)-1] != version {
+		packageParts = append(packageParts, version)
+	}
+	return strings.Join(packageParts, ".")
+}
+
+// prefixHTTPRules prepends prefix, such as /v1, to the path of
+// the HTTP rule of every method of sb.
+func prefixHTTPRules(sb *builder.ServiceBuilder, prefix string) {
+	for _, child := range sb.GetChildren() {
+		mb, ok := child.(*builder.MethodBuilder)
+		if !ok || mb.Options == nil || !protobuf.HasExtension(mb.Options, annotations.E_Http) {
+			continue
+		}
+		rule := protobuf.GetExtension(mb.Options, annotations.E_Http).(*annotations.HttpRule)
+		switch p := rule.Pattern.(type) {
+		case *annotations.HttpRule_Get:
+			p.Get = prefix + p.Get
+		case *annotations.HttpRule_Put:
+			p.Put = prefix + p.Put
+		case *annotations.HttpRule_Post:
+			p.Post = prefix + p.Post
+		case *annotations.HttpRule_Delete:
+			p.Delete = prefix + p.Delete
+		case *annotations.HttpRule_Patch:
+			p.Patch = prefix + p.Patch
+		case *annotations.HttpRule_Custom:
+			p.Custom.Path = prefix + p.Custom.Path
+		}
+	}
+}
+
+func toMessageName(name string) string {
+	return cases.SnakeToCamelCase(cases.KebabToSnakeCase(name))
+}
+
+func toProtoServiceName(serviceName string) string {
+	parts := strings.SplitN(serviceName, ".", 2)
+	return cases.Capitalize(parts[0])
+}
+
+func sortedResources(a *api.API) []*api.Resource {
+	keys := []string{}
+	for k := range a.Resources {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	resources := make([]*api.Resource, 0, len(keys))
+	for _, k := range keys {
+		resources = append(resources, a.Resources[k])
+	}
+	return resources
+}
+
+// compareProtoElements orders the elements of the printed
+// proto to adhere to the AEPs.
+func compareProtoElements(a, b protoprint.Element) bool {
+	return protoPrintKindToElement(a.Kind()) < protoPrintKindToElement(b.Kind())
+}
+
+func protoPrintKindToElement(ek protoprint.ElementKind) int {
+	switch ek {
+	case protoprint.KindPackage:
+		return 0
+	case protoprint.KindImport:
+		return 1
+	case protoprint.KindOption:
+		return 2
+	case protoprint.KindService:
+		return 4
+	case protoprint.KindEnum:
+		return 5
+	case protoprint.KindMessage:
+		return 6
+	case protoprint.KindField:
+		return 7
+	case protoprint.KindExtensionRange:
+		return 8
+	case protoprint.KindExtension:
+		return 9
+	case protoprint.KindReservedRange:
+		return 10
+	case protoprint.KindReservedName:
+		return 11
+	case protoprint.KindEnumValue:
+		return 12
+	case protoprint.KindMethod:
+		return 13
+	default:
+		return 99
+	}
+}
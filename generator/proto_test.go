@@ -0,0 +1,39 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aep-dev/aepc/loader"
+)
+
+func TestProtoPackage(t *testing.T) {
+	d, err := loader.Load("shop.yaml", []byte(unnumbered), loader.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssignFieldNumbers(d, nil)
+	g, err := Get("proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		outputDir string
+		want      string
+	}{
+		{outputDir: "example/shop/v1", want: "package example.shop.v1;"},
+		// the working directory, and stdout, follow the layout
+		// of the API.
+		{outputDir: ".", want: "package com.example.shop;"},
+		{outputDir: "", want: "package com.example.shop;"},
+	}
+	for _, tt := range tests {
+		got, err := Run(g, d, Options{OutputDir: tt.outputDir})
+		if err != nil {
+			t.Fatalf("Run(%q) error = %v", tt.outputDir, err)
+		}
+		if !strings.Contains(string(got), tt.want) {
+			t.Errorf("Run(%q) = %s, want %s", tt.outputDir, got, tt.want)
+		}
+	}
+}
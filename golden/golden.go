@@ -0,0 +1,232 @@
+// Package golden verifies the outputs generated from resource
+// definitions against checked-in golden files, in-process,
+// without buf or network access.
+package golden
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/aep-dev/aepc/cmd"
+	"github.com/aep-dev/aepc/generator"
+)
+
+// ErrSuffix is the suffix of the golden holding the error
+// returned when generating a fixture fails.
+const ErrSuffix = ".err"
+
+// Fixture is a resource definition and the goldens of the
+// outputs generated from it.
+type Fixture struct {
+	// Name identifies the fixture in test names.
+	Name string
+	// Input is the path of the definition. Its directory is
+	// copied so that imports resolve.
+	Input string
+	// Goldens is the directory holding a golden per output,
+	// named after Input with the suffix of its generator.
+	Goldens string
+	// OutputDir is the directory the outputs are generated to,
+	// relative to a scratch directory. The proto package is
+	// derived from it.
+	OutputDir string
+	// Lockfile is the path of the lockfile of the definition,
+	// relative to the directory of Input. It is a golden itself:
+	// generation must leave it unchanged. If empty, no lockfile
+	// is used.
+	Lockfile string
+	// Targets are the generators to run. If empty,
+	// generator.DefaultTargets are run.
+	Targets []string
+	// FormattedProto is set if the proto golden was rewritten
+	// by buf format. It is then compared token by token,
+	// ignoring whitespace and the commas buf removes.
+	FormattedProto bool
+}
+
+// Fixtures returns a fixture for every *.yaml definition in
+// dir. The goldens of dir/x.yaml are in dir/x, the proto is
+// generated to package x, and its lockfile, if any, is x.lock.
+func Fixtures(dir string) ([]Fixture, error) {
+	inputs, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(inputs)
+	fixtures := []Fixture{}
+	for _, input := range inputs {
+		name := strings.TrimSuffix(filepath.Base(input), ".yaml")
+		f := Fixture{
+			Name:      name,
+			Input:     input,
+			Goldens:   filepath.Join(dir, name),
+			OutputDir: name,
+		}
+		if _, err := os.Stat(filepath.Join(dir, name+".lock")); err == nil {
+			f.Lockfile = name + ".lock"
+		}
+		fixtures = append(fixtures, f)
+	}
+	return fixtures, nil
+}
+
+// Verify generates the outputs of f with cmd.ProcessInput in a
+// scratch directory and compares each to its golden. If
+// generation fails, the error is compared to the golden with
+// ErrSuffix instead. If update is set, the goldens that differ
+// are rewritten rather than reported, and stale ones removed.
+//
+// Verify changes the working directory for the duration of
+// the test, so it must not be run in parallel.
+func Verify(t *testing.T, f Fixture, update bool) {
+	t.Helper()
+	var err error
+	// the paths of f are relative to the working directory,
+	// which generate changes.
+	if f.Input, err = filepath.Abs(f.Input); err != nil {
+		t.Fatal(err)
+	}
+	if f.Goldens, err = filepath.Abs(f.Goldens); err != nil {
+		t.Fatal(err)
+	}
+	got, err := generate(t, f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := strings.TrimSuffix(filepath.Base(f.Input), filepath.Ext(f.Input))
+	gens, err := generator.Resolve(f.Targets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	goldens := []string{filepath.Join(f.Goldens, base+ErrSuffix)}
+	for _, g := range gens {
+		goldens = append(goldens, filepath.Join(f.Goldens, base+g.Suffix()))
+	}
+	if f.Lockfile != "" {
+		goldens = append(goldens, filepath.Join(filepath.Dir(f.Input), f.Lockfile))
+	}
+	for _, golden := range goldens {
+		output, generated := got[golden]
+		want, err := os.ReadFile(golden)
+		exists := err == nil
+		if err != nil && !os.IsNotExist(err) {
+			t.Fatal(err)
+		}
+		same := bytes.Equal(output, want)
+		if f.FormattedProto && strings.HasSuffix(golden, ".proto") {
+			same = slices.Equal(protoTokens(output), protoTokens(want))
+		}
+		switch {
+		case generated && exists && same:
+		case !generated && !exists:
+		case update && generated:
+			if err := os.MkdirAll(filepath.Dir(golden), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(golden, output, 0644); err != nil {
+				t.Fatal(err)
+			}
+		case update:
+			if err := os.Remove(golden); err != nil {
+				t.Fatal(err)
+			}
+		case !generated:
+			t.Errorf("%s was not generated", golden)
+		case !exists:
+			t.Errorf("%s is missing, generated:\n%s", golden, output)
+		default:
+			t.Errorf("%s differs, generated:\n%s", golden, output)
+		}
+	}
+}
+
+// protoTokenPattern matches a token of a proto file: a string
+// literal, an identifier or number, or a punctuation mark.
+var protoTokenPattern = regexp.MustCompile(`"(?:[^"\\]|\\.)*"|[\w.]+|\S`)
+
+// protoTokens returns the tokens of a proto file, with each
+// comment line as a single token. The commas separating the
+// fields of message literals, such as the values of options,
+// are optional and dropped.
+func protoTokens(b []byte) []string {
+	tokens := []string{}
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "//") {
+			tokens = append(tokens, line)
+			continue
+		}
+		tokens = append(tokens, protoTokenPattern.FindAllString(line, -1)...)
+	}
+	kept := []string{}
+	for i, t := range tokens {
+		if t == "," && (i+1 < len(tokens) && tokens[i+1] == "}" || i+2 < len(tokens) && tokens[i+2] == ":") {
+			continue
+		}
+		kept = append(kept, t)
+	}
+	return kept
+}
+
+// generate runs cmd.ProcessInput for f in a scratch directory,
+// returning each output keyed by the path of its golden.
+func generate(t *testing.T, f Fixture) (map[string][]byte, error) {
+	inputDir := filepath.Dir(f.Input)
+	base := filepath.Base(f.Input)
+	name := strings.TrimSuffix(base, filepath.Ext(base))
+	scratch := t.TempDir()
+	// inputs are copied so that error messages, and the
+	// lockfile, do not depend on where the fixture lives.
+	src := filepath.Join(scratch, "src")
+	if err := os.CopyFS(src, os.DirFS(inputDir)); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Join(scratch, f.OutputDir), 0755); err != nil {
+		return nil, err
+	}
+	t.Chdir(scratch)
+	opts := cmd.Options{
+		Targets:    f.Targets,
+		Quiet:      true,
+		NoLockfile: f.Lockfile == "",
+	}
+	if f.Lockfile != "" {
+		opts.Lockfile = filepath.Join("src", f.Lockfile)
+	}
+	prefix := filepath.Join(f.OutputDir, name)
+	got := map[string][]byte{}
+	if err := cmd.ProcessInputWithOptions(filepath.Join("src", base), prefix, opts); err != nil {
+		got[filepath.Join(f.Goldens, name+ErrSuffix)] = []byte(err.Error() + "\n")
+		return got, nil
+	}
+	err := fs.WalkDir(os.DirFS(f.OutputDir), ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		b, err := os.ReadFile(filepath.Join(f.OutputDir, path))
+		if err != nil {
+			return err
+		}
+		got[filepath.Join(f.Goldens, path)] = b
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if f.Lockfile != "" {
+		b, err := os.ReadFile(opts.Lockfile)
+		if err != nil {
+			return nil, fmt.Errorf("lockfile was not written: %w", err)
+		}
+		got[filepath.Join(inputDir, f.Lockfile)] = b
+	}
+	return got, nil
+}
@@ -0,0 +1,58 @@
+package golden
+
+import (
+	"flag"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the goldens instead of comparing them")
+
+func TestFixtures(t *testing.T) {
+	fixtures, err := Fixtures("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fixtures) == 0 {
+		t.Fatal("no fixtures in testdata")
+	}
+	for _, f := range fixtures {
+		t.Run(f.Name, func(t *testing.T) {
+			Verify(t, f, *update)
+		})
+	}
+}
+
+func TestBookstore(t *testing.T) {
+	Verify(t, Fixture{
+		Name:      "bookstore",
+		Input:     "../example/bookstore/v1/bookstore.yaml",
+		Goldens:   "../example/bookstore/v1",
+		OutputDir: "example/bookstore/v1",
+		Lockfile:  "aepc.lock",
+		// the proto golden is formatted by buf.
+		FormattedProto: true,
+	}, *update)
+}
+
+func TestProtoTokens(t *testing.T) {
+	generated := `rpc GetBook ( GetBookRequest ) returns ( Book ) {
+    option (google.api.http) = { get: "/{path=books/*}", body: "*" };
+}
+`
+	formatted := `rpc GetBook(GetBookRequest) returns (Book) {
+  option (google.api.http) = {
+    get: "/{path=books/*}"
+    body: "*"
+  };
+}
+`
+	if got, want := protoTokens([]byte(generated)), protoTokens([]byte(formatted)); !slices.Equal(got, want) {
+		t.Errorf("protoTokens() = %q, want %q", got, want)
+	}
+	changed := strings.Replace(formatted, `"*"`, `"book"`, 1)
+	if slices.Equal(protoTokens([]byte(formatted)), protoTokens([]byte(changed))) {
+		t.Errorf("protoTokens() ignored a changed value")
+	}
+}
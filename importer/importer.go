@@ -0,0 +1,146 @@
+// Package importer converts existing API descriptions into
+// resource definitions.
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aepc/format"
+	"github.com/ghodss/yaml"
+)
+
+// Result is an imported API.
+type Result struct {
+	API *api.API
+	// Enums maps the JSON pointer of properties of the
+	// definition to their allowed values, which the API does
+	// not represent.
+	Enums map[string][]string
+	// Unmapped are the endpoints of the source that are not
+	// generated from the definition.
+	Unmapped []Endpoint
+	// Warnings describe the parts of the source that the
+	// definition represents with a loss, such as field types
+	// that generation does not reproduce.
+	Warnings []string
+}
+
+// Endpoint is an HTTP method and path.
+type Endpoint struct {
+	Method string
+	Path   string
+}
+
+func (e Endpoint) String() string {
+	return e.Method + " " + e.Path
+}
+
+// Definition serializes the imported API as a resource
+// definition, in the canonical form of the format given by
+// ext (".yaml" or ".json").
+func (r *Result) Definition(ext string) ([]byte, error) {
+	b, err := json.Marshal(r.API)
+	if err != nil {
+		return nil, err
+	}
+	var v map[string]any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+	// api.API and api.CustomMethod serialize some fields with
+	// their Go names; definitions use the snake_case names.
+	lowerKeys(v, "Name", "Contact", "Schemas", "Resources")
+	if contact, ok := v["contact"].(map[string]any); ok {
+		lowerKeys(contact, "Name", "Email", "URL")
+		for k, c := range contact {
+			if c == "" {
+				delete(contact, k)
+			}
+		}
+	}
+	if v["contact"] == nil {
+		delete(v, "contact")
+	}
+	if v["server_url"] == "" {
+		delete(v, "server_url")
+	}
+	resources, _ := v["resources"].(map[string]any)
+	for _, res := range resources {
+		methods, _ := res.(map[string]any)["methods"].(map[string]any)
+		for _, m := range methods {
+			dropUnset(m.(map[string]any))
+		}
+		cms, _ := res.(map[string]any)["custom_methods"].([]any)
+		for _, cm := range cms {
+			lowerKeys(cm.(map[string]any), "Name", "Method", "Request", "Response")
+			dropUnset(cm.(map[string]any))
+		}
+	}
+	if schemas, _ := v["schemas"].(map[string]any); len(schemas) == 0 {
+		delete(v, "schemas")
+	}
+	for _, pointer := range sortedKeys(r.Enums) {
+		property, ok := lookup(v, pointer).(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("unable to locate enum %s", pointer)
+		}
+		property["enum"] = r.Enums[pointer]
+	}
+	b, err = json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if ext == ".yaml" {
+		b, err = yaml.JSONToYAML(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return format.Definition(ext, b, format.Options{})
+}
+
+func lowerKeys(m map[string]any, keys ...string) {
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			delete(m, k)
+			m[strings.ToLower(k)] = v
+		}
+	}
+}
+
+// dropUnset deletes the flags and schemas of m that are unset.
+func dropUnset(m map[string]any) {
+	for k, v := range m {
+		if v == false || v == nil {
+			delete(m, k)
+		}
+	}
+}
+
+var pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// lookup returns the value at pointer in v, or nil.
+func lookup(v any, pointer string) any {
+	for _, token := range strings.Split(pointer, "/")[1:] {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = m[pointerUnescaper.Replace(token)]
+	}
+	return v
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,256 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aep-lib-go/pkg/cases"
+	"github.com/aep-dev/aep-lib-go/pkg/constants"
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+	"github.com/aep-dev/aepc/loader"
+	"github.com/ghodss/yaml"
+)
+
+// OpenAPIOptions configure OpenAPI.
+type OpenAPIOptions struct {
+	// ServerURL overrides the server URL of the spec.
+	ServerURL string
+	// PathPrefix is stripped from the paths of the spec, e.g.
+	// "/v1". Paths without it are reported as unmapped.
+	PathPrefix string
+}
+
+// OpenAPI imports an OpenAPI 3 spec, in JSON or YAML. Resources
+// are inferred from the paths and the schemas they respond
+// with, parents from nested paths, and standard and custom
+// methods from the operations on each path.
+func OpenAPI(spec []byte, opts OpenAPIOptions) (*Result, error) {
+	asJSON, err := yaml.YAMLToJSON(spec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode spec: %w", err)
+	}
+	o := &openapi.OpenAPI{}
+	if err := json.Unmarshal(asJSON, o); err != nil {
+		return nil, fmt.Errorf("unable to decode spec: %w", err)
+	}
+	var raw map[string]any
+	if err := json.Unmarshal(asJSON, &raw); err != nil {
+		return nil, fmt.Errorf("unable to decode spec: %w", err)
+	}
+	sourceEndpoints := endpoints(o)
+	unreachable := stripUnreachable(o)
+	// api.GetAPI expects every path to have the prefix.
+	for path := range o.Paths {
+		if !strings.HasPrefix(path, opts.PathPrefix) {
+			delete(o.Paths, path)
+		}
+	}
+	a, err := api.GetAPI(o, opts.ServerURL, opts.PathPrefix)
+	if err != nil {
+		return nil, err
+	}
+	r := &Result{API: a, Enums: map[string][]string{}}
+	r.normalize(o, opts.PathPrefix, unreachable)
+	r.collectEnums(raw)
+
+	b, err := r.Definition(".json")
+	if err != nil {
+		return nil, err
+	}
+	d, err := loader.Load("imported.json", b, loader.Options{})
+	if err != nil {
+		return nil, fmt.Errorf("imported definition does not load: %w", err)
+	}
+	generated, err := api.ConvertToOpenAPI(d.API)
+	if err != nil {
+		return nil, err
+	}
+	mapped := map[Endpoint]bool{}
+	for _, e := range endpoints(generated) {
+		mapped[normalizeEndpoint(e)] = true
+	}
+	for _, e := range sourceEndpoints {
+		trimmed := Endpoint{Method: e.Method, Path: strings.TrimPrefix(e.Path, opts.PathPrefix)}
+		if !strings.HasPrefix(e.Path, opts.PathPrefix) || !mapped[normalizeEndpoint(trimmed)] {
+			r.Unmapped = append(r.Unmapped, e)
+		}
+	}
+	return r, nil
+}
+
+// normalize keys resources by their singular, and removes what
+// generation adds back: the implicit fields of resources, their
+// x-aep-resource annotations, and the shared schemas duplicating
+// resources. For resources without x-aep-resource, the plural
+// and parents are inferred from the paths. It also infers what
+// api.GetAPI does not: apply methods, and list methods returning
+// unreachable resources.
+func (r *Result) normalize(o *openapi.OpenAPI, pathPrefix string, unreachable map[string]bool) {
+	resources := map[string]*api.Resource{}
+	inferred := map[*api.Resource]bool{}
+	for key, res := range r.API.Resources {
+		for name := range r.API.Schemas {
+			if name == res.Singular || cases.PascalToSnakeCase(name) == key {
+				delete(r.API.Schemas, name)
+			}
+		}
+		elems := res.PatternElems()
+		if res.Schema == nil || res.Schema.XAEPResource == nil {
+			inferred[res] = true
+			res.Singular = cases.SnakeToKebabCase(res.Singular)
+			if len(elems) >= 2 {
+				res.Plural = elems[len(elems)-2]
+			}
+		}
+		resources[res.Singular] = res
+		if res.Schema != nil {
+			res.Schema.XAEPResource = nil
+			delete(res.Schema.Properties, constants.FIELD_PATH_NAME)
+			if len(res.Schema.Properties) == 0 {
+				res.Schema.Properties = nil
+			}
+		}
+		if item, ok := o.Paths[pathPrefix+"/"+res.GetPattern()]; ok && item.Put != nil {
+			res.Methods.Apply = &api.ApplyMethod{IsLongRunning: item.Put.XAEPLongRunningOperation != nil}
+		}
+		if res.Methods.List != nil && len(elems) >= 2 {
+			collection := pathPrefix + "/" + strings.Join(elems[:len(elems)-1], "/")
+			res.Methods.List.HasUnreachableResources = unreachable[collection]
+		}
+	}
+	for res := range inferred {
+		elems := res.PatternElems()
+		if len(elems) < 4 {
+			continue
+		}
+		parentPattern := normalizePath(strings.Join(elems[:len(elems)-2], "/"))
+		for _, parent := range resources {
+			if parent != res && normalizePath(parent.GetPattern()) == parentPattern {
+				res.Parents = []string{parent.Singular}
+				// generation drops the parent from the collection,
+				// e.g. book-editions becomes editions.
+				if strings.HasPrefix(res.Singular, parent.Singular+"-") && !strings.HasPrefix(res.Plural, parent.Singular+"-") {
+					res.Plural = parent.Singular + "-" + res.Plural
+				}
+				break
+			}
+		}
+	}
+	for _, res := range resources {
+		if len(res.Parents) == 0 {
+			res.Parents = nil
+		}
+	}
+	r.API.Resources = resources
+}
+
+// stripUnreachable removes the unreachable property from the
+// responses of list operations, returning their paths.
+// api.GetAPI takes the first array property of the response as
+// the resources listed, which may otherwise be the unreachable
+// ones.
+func stripUnreachable(o *openapi.OpenAPI) map[string]bool {
+	paths := map[string]bool{}
+	for path, item := range o.Paths {
+		if item.Get == nil {
+			continue
+		}
+		resp, ok := item.Get.Responses["200"]
+		if !ok {
+			continue
+		}
+		s := o.GetSchemaFromResponse(resp, openapi.APPLICATION_JSON)
+		if s == nil {
+			continue
+		}
+		s, err := o.DereferenceSchema(*s)
+		if err != nil {
+			continue
+		}
+		if p, ok := s.Properties[constants.FIELD_UNREACHABLE_NAME]; ok && p.Type == "array" {
+			// Properties is shared with the spec.
+			delete(s.Properties, constants.FIELD_UNREACHABLE_NAME)
+			paths[path] = true
+		}
+	}
+	return paths
+}
+
+// collectEnums records the enums of the component schemas in
+// raw, the decoded spec, at their location in the definition.
+func (r *Result) collectEnums(raw map[string]any) {
+	components, _ := lookup(raw, "/components/schemas").(map[string]any)
+	for name, s := range components {
+		pointer := "/schemas/" + pointerEscaper.Replace(name)
+		if _, ok := r.API.Resources[name]; ok {
+			pointer = "/resources/" + pointerEscaper.Replace(name) + "/schema"
+		} else if _, ok := r.API.Schemas[name]; !ok {
+			continue
+		}
+		r.walkEnums(pointer, s)
+	}
+}
+
+func (r *Result) walkEnums(pointer string, s any) {
+	m, ok := s.(map[string]any)
+	if !ok {
+		return
+	}
+	if values, ok := m["enum"].([]any); ok {
+		strs := []string{}
+		for _, v := range values {
+			if str, ok := v.(string); ok {
+				strs = append(strs, str)
+			}
+		}
+		r.Enums[pointer] = strs
+	}
+	properties, _ := m["properties"].(map[string]any)
+	for name, p := range properties {
+		r.walkEnums(pointer+"/properties/"+pointerEscaper.Replace(name), p)
+	}
+	r.walkEnums(pointer+"/items", m["items"])
+}
+
+// endpoints returns the operations of o, sorted by path then
+// method.
+func endpoints(o *openapi.OpenAPI) []Endpoint {
+	es := []Endpoint{}
+	for path, item := range o.Paths {
+		for method, op := range map[string]*openapi.Operation{
+			http.MethodGet:    item.Get,
+			http.MethodPost:   item.Post,
+			http.MethodPut:    item.Put,
+			http.MethodPatch:  item.Patch,
+			http.MethodDelete: item.Delete,
+		} {
+			if op != nil {
+				es = append(es, Endpoint{Method: method, Path: path})
+			}
+		}
+	}
+	sort.Slice(es, func(i, j int) bool {
+		if es[i].Path != es[j].Path {
+			return es[i].Path < es[j].Path
+		}
+		return es[i].Method < es[j].Method
+	})
+	return es
+}
+
+var pathParam = regexp.MustCompile(`\{[^}]*\}`)
+
+// normalizeEndpoint drops the names of path parameters, which
+// generation may choose differently.
+func normalizeEndpoint(e Endpoint) Endpoint {
+	return Endpoint{Method: e.Method, Path: normalizePath(e.Path)}
+}
+
+func normalizePath(path string) string {
+	return pathParam.ReplaceAllString(path, "{}")
+}
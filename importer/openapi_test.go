@@ -0,0 +1,126 @@
+package importer
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/aep-dev/aepc/generator"
+	"github.com/aep-dev/aepc/loader"
+)
+
+const golden = "../example/bookstore/v1/bookstore_openapi.json"
+
+func TestOpenAPIRoundTrip(t *testing.T) {
+	spec, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := OpenAPI(spec, OpenAPIOptions{})
+	if err != nil {
+		t.Fatalf("OpenAPI() error = %v", err)
+	}
+	if len(r.Unmapped) > 0 {
+		t.Errorf("OpenAPI() unmapped = %v, want none", r.Unmapped)
+	}
+	for _, ext := range []string{".yaml", ".json"} {
+		def, err := r.Definition(ext)
+		if err != nil {
+			t.Fatalf("Definition(%q) error = %v", ext, err)
+		}
+		d, err := loader.Load("bookstore"+ext, def, loader.Options{Strict: true})
+		if err != nil {
+			t.Fatalf("Load() error = %v\n%s", err, def)
+		}
+		g, err := generator.Get("openapi-json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := generator.Run(g, d, generator.Options{})
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+		if string(got) != string(spec) {
+			t.Errorf("%s: generated spec differs from %s", ext, golden)
+		}
+	}
+}
+
+func TestOpenAPIUnmapped(t *testing.T) {
+	spec := `
+openapi: 3.1.0
+info: {title: shop.example.com}
+servers: [{url: "http://localhost"}]
+paths:
+  /shelves:
+    get:
+      responses:
+        "200":
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  results: {type: array, items: {$ref: "#/components/schemas/shelf"}}
+  /shelves/{shelf_id}:
+    get:
+      responses:
+        "200":
+          content:
+            application/json:
+              schema: {$ref: "#/components/schemas/shelf"}
+  /shelves/{shelf_id}/books/{book_id}:
+    get:
+      responses:
+        "200":
+          content:
+            application/json:
+              schema: {$ref: "#/components/schemas/book"}
+  /healthz:
+    get:
+      responses:
+        "200": {description: ok}
+  /shelves/{shelf_id}/books/{book_id}/read/now:
+    post:
+      responses:
+        "200": {description: ok}
+components:
+  schemas:
+    shelf:
+      type: object
+      properties:
+        theme: {type: string}
+    book:
+      type: object
+      properties:
+        title: {type: string}
+`
+	r, err := OpenAPI([]byte(spec), OpenAPIOptions{})
+	if err != nil {
+		t.Fatalf("OpenAPI() error = %v", err)
+	}
+	want := []Endpoint{
+		{"GET", "/healthz"},
+		{"POST", "/shelves/{shelf_id}/books/{book_id}/read/now"},
+	}
+	if !reflect.DeepEqual(r.Unmapped, want) {
+		t.Errorf("OpenAPI() unmapped = %v, want %v", r.Unmapped, want)
+	}
+	res, ok := r.API.Resources["shelf"]
+	if !ok {
+		t.Fatalf("OpenAPI() resources = %v, want shelf", r.API.Resources)
+	}
+	if res.Plural != "shelves" {
+		t.Errorf("shelf plural = %q, want shelves", res.Plural)
+	}
+	if res.Methods.Get == nil || res.Methods.List == nil {
+		t.Errorf("shelf methods = %+v, want get and list", res.Methods)
+	}
+	book, ok := r.API.Resources["book"]
+	if !ok {
+		t.Fatalf("OpenAPI() resources = %v, want book", r.API.Resources)
+	}
+	if !reflect.DeepEqual(book.Parents, []string{"shelf"}) {
+		t.Errorf("book parents = %v, want [shelf]", book.Parents)
+	}
+}
@@ -0,0 +1,542 @@
+package importer
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	apipb "buf.build/gen/go/aep/api/protocolbuffers/go/aep/api"
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aep-lib-go/pkg/cases"
+	"github.com/aep-dev/aep-lib-go/pkg/constants"
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+	"github.com/aep-dev/aepc/loader"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// ProtoOptions configure Proto.
+type ProtoOptions struct {
+	// ImportPaths are the directories imports are resolved
+	// from. Imports not found in them are resolved from the
+	// protos compiled into aepc, such as aep/api/resource.proto,
+	// google/api/annotations.proto and the well-known types.
+	ImportPaths []string
+	// ServerURL is the server URL of the definition, which a
+	// proto does not declare.
+	ServerURL string
+}
+
+const operationMessage = "aep.api.Operation"
+
+// Proto imports the proto file at path, annotated with
+// aep.api.resource and google.api.http options. Resources are
+// the messages with an aep.api.resource option, their parents
+// are inferred from their patterns, and their methods from the
+// HTTP rules of the RPCs of the file's services.
+func Proto(path string, opts ProtoOptions) (*Result, error) {
+	name, importPaths := protoImportPath(path, opts.ImportPaths)
+	p := protoparse.Parser{
+		ImportPaths:           importPaths,
+		IncludeSourceCodeInfo: true,
+		LookupImport:          desc.LoadFileDescriptor,
+	}
+	fds, err := p.ParseFiles(name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+	fd := fds[0]
+	i := &protoImporter{
+		fd:        fd,
+		resources: map[string]*api.Resource{},
+		messages:  map[string]*desc.MessageDescriptor{},
+		used:      map[string]bool{},
+		refs:      map[string]string{},
+		schemas:   map[string]*openapi.Schema{},
+		r:         &Result{Enums: map[string][]string{}},
+	}
+	if err := i.importResources(); err != nil {
+		return nil, err
+	}
+	for _, sd := range fd.GetServices() {
+		for _, md := range sd.GetMethods() {
+			if err := i.importMethod(md); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := i.importSchemas(); err != nil {
+		return nil, err
+	}
+	i.r.API = &api.API{
+		Name:      i.name,
+		ServerURL: opts.ServerURL,
+		Schemas:   i.schemas,
+		Resources: map[string]*api.Resource{},
+	}
+	for _, res := range i.resources {
+		i.r.API.Resources[res.Singular] = res
+	}
+	return i.r, nil
+}
+
+// protoImportPath returns the name to parse path as and the
+// import paths to parse it with: path relative to the first
+// import path containing it, or else to its directory.
+func protoImportPath(path string, importPaths []string) (string, []string) {
+	for _, dir := range importPaths {
+		rel, err := filepath.Rel(dir, path)
+		if err == nil && !strings.HasPrefix(rel, "..") {
+			return filepath.ToSlash(rel), importPaths
+		}
+	}
+	return filepath.Base(path), append([]string{filepath.Dir(path)}, importPaths...)
+}
+
+type protoImporter struct {
+	fd   *desc.FileDescriptor
+	name string
+	// resources are keyed by the full name of their message.
+	resources map[string]*api.Resource
+	// patterns maps the pattern of each resource, with its
+	// variables replaced by "*", to its message.
+	patterns map[string]string
+	// messages are the top-level messages of the file that are
+	// not resources, keyed by full name.
+	messages map[string]*desc.MessageDescriptor
+	// used are the messages that are the request or response of
+	// an RPC, which generation derives from the resources.
+	used map[string]bool
+	// refs maps the messages of the file that are the type of a
+	// field to the first such field.
+	refs    map[string]string
+	schemas map[string]*openapi.Schema
+	r       *Result
+}
+
+func (i *protoImporter) importResources() error {
+	i.patterns = map[string]string{}
+	for _, md := range i.fd.GetMessageTypes() {
+		rd := resourceDescriptor(md)
+		if rd == nil {
+			i.messages[md.GetFullyQualifiedName()] = md
+			continue
+		}
+		if len(rd.GetPattern()) == 0 {
+			return fmt.Errorf("resource %s declares no pattern", md.GetFullyQualifiedName())
+		}
+		if domain, _, ok := strings.Cut(rd.GetType(), "/"); ok && i.name == "" {
+			i.name = domain
+		}
+		i.resources[md.GetFullyQualifiedName()] = &api.Resource{
+			Singular: rd.GetSingular(),
+			Plural:   rd.GetPlural(),
+		}
+		i.patterns[wildcardPattern(rd.GetPattern()[0])] = md.GetFullyQualifiedName()
+	}
+	if len(i.resources) == 0 {
+		return fmt.Errorf("%s declares no messages with an aep.api.resource option", i.fd.GetName())
+	}
+	for _, md := range i.fd.GetMessageTypes() {
+		res, ok := i.resources[md.GetFullyQualifiedName()]
+		if !ok {
+			continue
+		}
+		pattern := strings.Split(wildcardPattern(resourceDescriptor(md).GetPattern()[0]), "/")
+		if len(pattern) >= 4 {
+			parent, ok := i.patterns[strings.Join(pattern[:len(pattern)-2], "/")]
+			if !ok {
+				return fmt.Errorf("resource %s: no resource matches the parent of its pattern", md.GetFullyQualifiedName())
+			}
+			res.Parents = []string{i.resources[parent].Singular}
+		}
+		s, err := i.schema(md, "/resources/"+pointerEscaper.Replace(res.Singular)+"/schema")
+		if err != nil {
+			return err
+		}
+		res.Schema = s
+	}
+	return nil
+}
+
+// importMethod maps the RPC md to a method of a resource,
+// using its HTTP rule. RPCs that do not map are unmapped.
+func (i *protoImporter) importMethod(md *desc.MethodDescriptor) error {
+	unmapped := Endpoint{Method: "RPC", Path: "/" + md.GetService().GetFullyQualifiedName() + "/" + md.GetName()}
+	rule, _ := proto.GetExtension(md.GetMethodOptions(), annotations.E_Http).(*annotations.HttpRule)
+	method, path := httpRule(rule)
+	if method == "" {
+		i.r.Unmapped = append(i.r.Unmapped, unmapped)
+		return nil
+	}
+	unmapped = Endpoint{Method: method, Path: path}
+	template, custom, _ := strings.Cut(path, ":")
+	input, output := md.GetInputType(), md.GetOutputType()
+	isLongRunning := output.GetFullyQualifiedName() == operationMessage
+	if variable, resourcePath, ok := pathTemplate(template); ok && variable == "path" {
+		res := i.resources[i.patterns[resourcePath]]
+		if res == nil {
+			i.r.Unmapped = append(i.r.Unmapped, unmapped)
+			return nil
+		}
+		switch {
+		case custom != "":
+			cm, err := i.customMethod(res, custom, method, md)
+			if err != nil {
+				return err
+			}
+			res.CustomMethods = append(res.CustomMethods, cm)
+		case method == http.MethodGet:
+			res.Methods.Get = &api.GetMethod{}
+		case method == http.MethodPatch:
+			res.Methods.Update = &api.UpdateMethod{IsLongRunning: isLongRunning}
+		case method == http.MethodDelete:
+			res.Methods.Delete = &api.DeleteMethod{IsLongRunning: isLongRunning}
+		case method == http.MethodPut:
+			res.Methods.Apply = &api.ApplyMethod{IsLongRunning: isLongRunning}
+		default:
+			i.r.Unmapped = append(i.r.Unmapped, unmapped)
+			return nil
+		}
+	} else {
+		// a collection, either "/{parent=...}/plural" or
+		// "/plural".
+		collection := strings.TrimPrefix(template, "/")
+		if ok {
+			if variable != "parent" {
+				i.r.Unmapped = append(i.r.Unmapped, unmapped)
+				return nil
+			}
+			collection = resourcePath + strings.TrimPrefix(template, "/{"+variable+"="+resourcePath+"}")
+		}
+		res := i.resources[i.patterns[collection+"/*"]]
+		if res == nil || custom != "" {
+			i.r.Unmapped = append(i.r.Unmapped, unmapped)
+			return nil
+		}
+		switch method {
+		case http.MethodPost:
+			res.Methods.Create = &api.CreateMethod{
+				SupportsUserSettableCreate: input.FindFieldByName(constants.FIELD_ID_NAME) != nil,
+				IsLongRunning:              isLongRunning,
+			}
+		case http.MethodGet:
+			res.Methods.List = &api.ListMethod{
+				SupportsFilter:          input.FindFieldByName(constants.FIELD_FILTER_NAME) != nil,
+				SupportsSkip:            input.FindFieldByName(constants.FIELD_SKIP_NAME) != nil,
+				HasUnreachableResources: output.FindFieldByName(constants.FIELD_UNREACHABLE_NAME) != nil,
+			}
+		default:
+			i.r.Unmapped = append(i.r.Unmapped, unmapped)
+			return nil
+		}
+	}
+	i.used[input.GetFullyQualifiedName()] = true
+	i.used[output.GetFullyQualifiedName()] = true
+	return nil
+}
+
+// customMethod imports the RPC md, with the HTTP method method,
+// as the custom method name of res. Its response is the
+// response_type of its aep.api.operation_info option if it is
+// long-running.
+func (i *protoImporter) customMethod(res *api.Resource, name, method string, md *desc.MethodDescriptor) (*api.CustomMethod, error) {
+	cm := &api.CustomMethod{
+		Name:          name,
+		Method:        method,
+		IsLongRunning: md.GetOutputType().GetFullyQualifiedName() == operationMessage,
+	}
+	pointer := fmt.Sprintf("/resources/%s/custom_methods/%d", pointerEscaper.Replace(res.Singular), len(res.CustomMethods))
+	if method != http.MethodGet {
+		request, err := i.schema(md.GetInputType(), pointer+"/request")
+		if err != nil {
+			return nil, err
+		}
+		delete(request.Properties, constants.FIELD_PATH_NAME)
+		request.Required = slices.DeleteFunc(request.Required, func(n string) bool { return n == constants.FIELD_PATH_NAME })
+		if request.Description == fmt.Sprintf("Request message for the %v method", name) {
+			request.Description = ""
+		}
+		cm.Request = request
+	}
+	response := md.GetOutputType()
+	if cm.IsLongRunning {
+		info, _ := proto.GetExtension(md.GetMethodOptions(), apipb.E_OperationInfo).(*apipb.OperationInfo)
+		response = nil
+		if info.GetResponseType() != "" {
+			response = findMessage(i.fd, strings.TrimPrefix(info.GetResponseType(), "."))
+			if response == nil {
+				return nil, fmt.Errorf("%s: unable to find response type %s", md.GetFullyQualifiedName(), info.GetResponseType())
+			}
+		}
+	}
+	if response != nil && response.GetFullyQualifiedName() != "google.protobuf.Empty" {
+		s, err := i.schema(response, pointer+"/response")
+		if err != nil {
+			return nil, err
+		}
+		if s.Description == fmt.Sprintf("Response message for the %v method", name) {
+			s.Description = ""
+		}
+		cm.Response = s
+		i.used[response.GetFullyQualifiedName()] = true
+	}
+	return cm, nil
+}
+
+// importSchemas imports the top-level messages that are neither
+// resources nor the request or response of an RPC as shared
+// schemas. A field cannot reference a request or response, as
+// generation derives them under the same name.
+func (i *protoImporter) importSchemas() error {
+	names := []string{}
+	for name := range i.messages {
+		if !i.used[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		md := i.messages[name]
+		schemaName := schemaName(md)
+		if _, ok := i.schemas[schemaName]; ok {
+			continue
+		}
+		s, err := i.schema(md, "/schemas/"+pointerEscaper.Replace(schemaName))
+		if err != nil {
+			return err
+		}
+		i.schemas[schemaName] = s
+	}
+	for _, name := range sortedKeys(i.refs) {
+		if i.used[name] {
+			return fmt.Errorf("%s: type %s is the request or response of an RPC, which cannot be the type of a field", i.refs[name], name)
+		}
+	}
+	return nil
+}
+
+// schema returns the schema of the message md, located at
+// pointer in the definition. The path field of resources is
+// omitted, as generation adds it back.
+func (i *protoImporter) schema(md *desc.MessageDescriptor, pointer string) (*openapi.Schema, error) {
+	s := &openapi.Schema{
+		Type:        "object",
+		Description: comment(md.GetSourceInfo(), fmt.Sprintf("A %v.", md.GetName())),
+	}
+	_, isResource := i.resources[md.GetFullyQualifiedName()]
+	for _, f := range md.GetFields() {
+		if isResource && f.GetName() == constants.FIELD_PATH_NAME {
+			continue
+		}
+		p, err := i.fieldSchema(f, pointer+"/properties/"+pointerEscaper.Replace(f.GetName()))
+		if err != nil {
+			return nil, err
+		}
+		p.XAEPField = &openapi.XAEPField{FieldNumber: int(f.GetNumber())}
+		p.Description = comment(f.GetSourceInfo(), fmt.Sprintf("Field for %v.", f.GetName()))
+		behaviors := fieldBehaviors(f)
+		if behaviors[apipb.FieldBehavior_FIELD_BEHAVIOR_REQUIRED] {
+			s.Required = append(s.Required, f.GetName())
+		}
+		p.ReadOnly = behaviors[apipb.FieldBehavior_FIELD_BEHAVIOR_OUTPUT_ONLY]
+		if s.Properties == nil {
+			s.Properties = openapi.Properties{}
+		}
+		s.Properties[f.GetName()] = *p
+	}
+	return s, nil
+}
+
+// fieldSchema returns the schema of the field f, located at
+// pointer in the definition.
+func (i *protoImporter) fieldSchema(f *desc.FieldDescriptor, pointer string) (*openapi.Schema, error) {
+	if f.IsMap() {
+		return nil, fmt.Errorf("%s: map fields are unsupported", f.GetFullyQualifiedName())
+	}
+	if f.IsRepeated() {
+		pointer += "/items"
+	}
+	var s *openapi.Schema
+	switch f.GetType() {
+	case descriptorpb.FieldDescriptorProto_TYPE_STRING:
+		s = &openapi.Schema{Type: "string"}
+	case descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+		s = &openapi.Schema{Type: "string", Format: "byte"}
+		i.warnf("%s: bytes is imported as a string of format byte, which is generated as a string", f.GetFullyQualifiedName())
+	case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
+		s = &openapi.Schema{Type: "boolean"}
+	case descriptorpb.FieldDescriptorProto_TYPE_INT32, descriptorpb.FieldDescriptorProto_TYPE_SINT32,
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED32:
+		s = &openapi.Schema{Type: "integer", Format: "int32"}
+	case descriptorpb.FieldDescriptorProto_TYPE_UINT32, descriptorpb.FieldDescriptorProto_TYPE_FIXED32:
+		s = &openapi.Schema{Type: "integer", Format: "int32"}
+		i.warnf("%s: %s is imported as a signed int32 integer", f.GetFullyQualifiedName(), protoTypeName(f))
+	case descriptorpb.FieldDescriptorProto_TYPE_INT64, descriptorpb.FieldDescriptorProto_TYPE_SINT64,
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED64:
+		s = &openapi.Schema{Type: "integer", Format: "int64"}
+	case descriptorpb.FieldDescriptorProto_TYPE_UINT64, descriptorpb.FieldDescriptorProto_TYPE_FIXED64:
+		s = &openapi.Schema{Type: "integer", Format: "int64"}
+		i.warnf("%s: %s is imported as a signed int64 integer", f.GetFullyQualifiedName(), protoTypeName(f))
+	case descriptorpb.FieldDescriptorProto_TYPE_DOUBLE:
+		s = &openapi.Schema{Type: "number", Format: "double"}
+	case descriptorpb.FieldDescriptorProto_TYPE_FLOAT:
+		s = &openapi.Schema{Type: "number", Format: "float"}
+	case descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+		s = &openapi.Schema{Type: "string"}
+		values := []string{}
+		for _, v := range f.GetEnumType().GetValues() {
+			// the unspecified value is generated.
+			if v.GetNumber() == 0 && strings.HasSuffix(v.GetName(), "_UNSPECIFIED") {
+				continue
+			}
+			values = append(values, v.GetName())
+		}
+		i.r.Enums[pointer] = values
+	case descriptorpb.FieldDescriptorProto_TYPE_MESSAGE:
+		var err error
+		s, err = i.messageFieldSchema(f, pointer)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%s: type %v is unsupported", f.GetFullyQualifiedName(), f.GetType())
+	}
+	if f.IsRepeated() {
+		return &openapi.Schema{Type: "array", Items: s}, nil
+	}
+	return s, nil
+}
+
+// messageFieldSchema returns the schema of the message field f:
+// nested messages inline, and a reference to the resource or
+// shared schema of any other message.
+func (i *protoImporter) messageFieldSchema(f *desc.FieldDescriptor, pointer string) (*openapi.Schema, error) {
+	md := f.GetMessageType()
+	if _, ok := md.GetParent().(*desc.MessageDescriptor); ok {
+		return i.schema(md, pointer)
+	}
+	if res, ok := i.resources[md.GetFullyQualifiedName()]; ok {
+		return &openapi.Schema{Type: "object", Ref: loader.SchemaRefPrefix + res.Singular}, nil
+	}
+	name := schemaName(md)
+	if md.GetFile() != i.fd {
+		// messages of other files are used as is.
+		i.schemas[name] = &openapi.Schema{Type: "object", XAEPProtoMessageName: md.GetFullyQualifiedName()}
+	} else if _, ok := i.refs[md.GetFullyQualifiedName()]; !ok {
+		i.refs[md.GetFullyQualifiedName()] = f.GetFullyQualifiedName()
+	}
+	return &openapi.Schema{Type: "object", Ref: loader.SchemaRefPrefix + name}, nil
+}
+
+func (i *protoImporter) warnf(format string, args ...any) {
+	i.r.Warnings = append(i.r.Warnings, fmt.Sprintf(format, args...))
+}
+
+// protoTypeName returns the name of the scalar type of f as
+// declared in a proto file, e.g. uint32.
+func protoTypeName(f *desc.FieldDescriptor) string {
+	return strings.ToLower(strings.TrimPrefix(f.GetType().String(), "TYPE_"))
+}
+
+// findMessage returns the message name declared by fd or the
+// files it imports, or nil.
+func findMessage(fd *desc.FileDescriptor, name string) *desc.MessageDescriptor {
+	if md := fd.FindMessage(name); md != nil {
+		return md
+	}
+	for _, dep := range fd.GetDependencies() {
+		if md := findMessage(dep, name); md != nil {
+			return md
+		}
+	}
+	return nil
+}
+
+func schemaName(md *desc.MessageDescriptor) string {
+	return cases.PascalToSnakeCase(md.GetName())
+}
+
+func resourceDescriptor(md *desc.MessageDescriptor) *apipb.ResourceDescriptor {
+	rd, _ := proto.GetExtension(md.GetMessageOptions(), apipb.E_Resource).(*apipb.ResourceDescriptor)
+	if rd.GetSingular() == "" {
+		return nil
+	}
+	return rd
+}
+
+// fieldBehaviors returns the behaviors of f, from either its
+// aep.api.field_info or its google.api.field_behavior option.
+func fieldBehaviors(f *desc.FieldDescriptor) map[apipb.FieldBehavior]bool {
+	opts := f.GetFieldOptions()
+	behaviors := map[apipb.FieldBehavior]bool{}
+	info, _ := proto.GetExtension(opts, apipb.E_FieldInfo).(*apipb.FieldInfo)
+	for _, b := range info.GetFieldBehavior() {
+		behaviors[b] = true
+	}
+	legacy, _ := proto.GetExtension(opts, annotations.E_FieldBehavior).([]annotations.FieldBehavior)
+	for _, b := range legacy {
+		switch b {
+		case annotations.FieldBehavior_REQUIRED:
+			behaviors[apipb.FieldBehavior_FIELD_BEHAVIOR_REQUIRED] = true
+		case annotations.FieldBehavior_OUTPUT_ONLY:
+			behaviors[apipb.FieldBehavior_FIELD_BEHAVIOR_OUTPUT_ONLY] = true
+		}
+	}
+	return behaviors
+}
+
+// comment returns the leading comment of an element, or "" if
+// it has none or it is the comment generation writes.
+func comment(loc *descriptorpb.SourceCodeInfo_Location, generated string) string {
+	c := strings.TrimSpace(loc.GetLeadingComments())
+	if c == generated {
+		return ""
+	}
+	return c
+}
+
+// httpRule returns the HTTP method and path of rule.
+func httpRule(rule *annotations.HttpRule) (string, string) {
+	switch p := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return http.MethodGet, p.Get
+	case *annotations.HttpRule_Post:
+		return http.MethodPost, p.Post
+	case *annotations.HttpRule_Put:
+		return http.MethodPut, p.Put
+	case *annotations.HttpRule_Patch:
+		return http.MethodPatch, p.Patch
+	case *annotations.HttpRule_Delete:
+		return http.MethodDelete, p.Delete
+	}
+	return "", ""
+}
+
+// pathTemplate returns the variable and the path it matches of
+// an HTTP path starting with a variable, such as
+// "/{path=publishers/*/books/*}".
+func pathTemplate(path string) (variable, matched string, ok bool) {
+	rest, ok := strings.CutPrefix(path, "/{")
+	if !ok {
+		return "", "", false
+	}
+	template, _, ok := strings.Cut(rest, "}")
+	if !ok {
+		return "", "", false
+	}
+	variable, matched, ok = strings.Cut(template, "=")
+	return variable, matched, ok
+}
+
+// wildcardPattern replaces the variables of a resource pattern
+// with "*", as in the paths of HTTP rules.
+func wildcardPattern(pattern string) string {
+	return pathParam.ReplaceAllString(strings.TrimPrefix(pattern, "/"), "*")
+}
@@ -0,0 +1,222 @@
+package importer
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/aep-dev/aepc/generator"
+	"github.com/aep-dev/aepc/loader"
+	"github.com/aep-dev/aepc/lock"
+)
+
+// generateProto generates the proto of the definition b, with
+// the field numbers of l assigned.
+func generateProto(t *testing.T, file string, b []byte, opts loader.Options, l *lock.Lock) string {
+	t.Helper()
+	d, err := loader.Load(file, b, opts)
+	if err != nil {
+		t.Fatalf("Load() error = %v\n%s", err, b)
+	}
+	if l != nil {
+		generator.AssignFieldNumbers(d, l)
+	}
+	g, err := generator.Get("proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := generator.Run(g, d, generator.Options{OutputDir: "example/bookstore/v1"})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	return string(got)
+}
+
+func TestProtoRoundTrip(t *testing.T) {
+	dir := "../example/bookstore/v1"
+	def, err := os.ReadFile(filepath.Join(dir, "bookstore.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := lock.Read(filepath.Join(dir, lock.File))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := generateProto(t, "bookstore.yaml", def, loader.Options{}, l)
+
+	r, err := Proto(filepath.Join(dir, "bookstore.proto"), ProtoOptions{ServerURL: "http://localhost:8081"})
+	if err != nil {
+		t.Fatalf("Proto() error = %v", err)
+	}
+	if len(r.Unmapped) > 0 {
+		t.Errorf("Proto() unmapped = %v, want none", r.Unmapped)
+	}
+	for _, ext := range []string{".yaml", ".json"} {
+		b, err := r.Definition(ext)
+		if err != nil {
+			t.Fatalf("Definition(%q) error = %v", ext, err)
+		}
+		if got := generateProto(t, "bookstore"+ext, b, loader.Options{Strict: true}, nil); got != want {
+			t.Errorf("%s: regenerated proto differs, got\n%s\nwant\n%s", ext, got, want)
+		}
+	}
+}
+
+func TestProtoImportPathsAndUnmapped(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"common/money.proto": `syntax = "proto3";
+package common;
+message Money {
+  string currency = 1;
+  int64 units = 2;
+}
+`,
+		"shop/shop.proto": `syntax = "proto3";
+package shop;
+import "aep/api/resource.proto";
+import "google/api/annotations.proto";
+import "common/money.proto";
+service Shop {
+  rpc GetShelf(GetShelfRequest) returns (Shelf) {
+    option (google.api.http) = {get: "/{path=shelves/*}"};
+  }
+  rpc Ping(GetShelfRequest) returns (Shelf);
+  rpc Restock(GetShelfRequest) returns (Shelf) {
+    option (google.api.http) = {post: "/v2/restock"};
+  }
+}
+message Shelf {
+  option (aep.api.resource) = {
+    type: "shop.example.com/shelf"
+    pattern: ["shelves/{shelf_id}"]
+    singular: "shelf"
+    plural: "shelves"
+  };
+  // The theme of the shelf.
+  string theme = 1;
+  common.Money price = 2;
+  Label label = 3;
+}
+message Label {
+  string text = 1;
+}
+message GetShelfRequest {
+  string path = 1;
+}
+`,
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r, err := Proto(filepath.Join(dir, "shop/shop.proto"), ProtoOptions{ImportPaths: []string{dir}})
+	if err != nil {
+		t.Fatalf("Proto() error = %v", err)
+	}
+	if r.API.Name != "shop.example.com" {
+		t.Errorf("Proto() name = %q, want shop.example.com", r.API.Name)
+	}
+	wantUnmapped := []Endpoint{
+		{"RPC", "/shop.Shop/Ping"},
+		{"POST", "/v2/restock"},
+	}
+	if !reflect.DeepEqual(r.Unmapped, wantUnmapped) {
+		t.Errorf("Proto() unmapped = %v, want %v", r.Unmapped, wantUnmapped)
+	}
+	shelf, ok := r.API.Resources["shelf"]
+	if !ok || shelf.Methods.Get == nil {
+		t.Fatalf("Proto() resources = %v, want shelf with a get method", r.API.Resources)
+	}
+	props := shelf.Schema.Properties
+	if got := props["theme"].Description; got != "The theme of the shelf." {
+		t.Errorf("theme description = %q", got)
+	}
+	if got := props["price"].Ref; got != loader.SchemaRefPrefix+"money" {
+		t.Errorf("price $ref = %q", got)
+	}
+	if got := props["label"].Ref; got != loader.SchemaRefPrefix+"label" {
+		t.Errorf("label $ref = %q", got)
+	}
+	if got := r.API.Schemas["money"]; got == nil || got.XAEPProtoMessageName != "common.Money" {
+		t.Errorf("money schema = %+v, want x-aep-proto-message-name common.Money", got)
+	}
+	if got := r.API.Schemas["label"]; got == nil || got.Properties["text"].Type != "string" {
+		t.Errorf("label schema = %+v, want a text property", got)
+	}
+	if _, ok := r.API.Schemas["get_shelf_request"]; ok {
+		t.Errorf("Proto() schemas = %v, want no request messages", r.API.Schemas)
+	}
+}
+
+// writeShopProto writes a proto declaring a shelf resource with
+// the fields given, and returns its path.
+func writeShopProto(t *testing.T, fields string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "shop.proto")
+	if err := os.WriteFile(path, []byte(`syntax = "proto3";
+package shop;
+import "aep/api/resource.proto";
+import "google/api/annotations.proto";
+service Shop {
+  rpc GetShelf(GetShelfRequest) returns (Shelf) {
+    option (google.api.http) = {get: "/{path=shelves/*}"};
+  }
+}
+message Shelf {
+  option (aep.api.resource) = {
+    type: "shop.example.com/shelf"
+    pattern: ["shelves/{shelf_id}"]
+    singular: "shelf"
+    plural: "shelves"
+  };
+`+fields+`}
+message GetShelfRequest {
+  string path = 1;
+}
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestProtoLossyMappings(t *testing.T) {
+	r, err := Proto(writeShopProto(t, `
+  bytes checksum = 1;
+  uint32 capacity = 2;
+  fixed64 weight = 3;
+`), ProtoOptions{})
+	if err != nil {
+		t.Fatalf("Proto() error = %v", err)
+	}
+	wantWarnings := []string{
+		"shop.Shelf.checksum: bytes is imported as a string of format byte, which is generated as a string",
+		"shop.Shelf.capacity: uint32 is imported as a signed int32 integer",
+		"shop.Shelf.weight: fixed64 is imported as a signed int64 integer",
+	}
+	if !reflect.DeepEqual(r.Warnings, wantWarnings) {
+		t.Errorf("Proto() warnings = %q, want %q", r.Warnings, wantWarnings)
+	}
+	if got := r.API.Resources["shelf"].Schema.Properties["checksum"]; got.Type != "string" || got.Format != "byte" {
+		t.Errorf("checksum = %s/%s, want string/byte", got.Type, got.Format)
+	}
+	b, err := r.Definition(".yaml")
+	if err != nil {
+		t.Fatalf("Definition() error = %v", err)
+	}
+	generateProto(t, "shop.yaml", b, loader.Options{Strict: true}, nil)
+}
+
+func TestProtoFieldOfRequestType(t *testing.T) {
+	_, err := Proto(writeShopProto(t, "  GetShelfRequest source = 1;\n"), ProtoOptions{})
+	want := "shop.Shelf.source: type shop.GetShelfRequest is the request or response of an RPC, which cannot be the type of a field"
+	if err == nil || err.Error() != want {
+		t.Errorf("Proto() error = %v, want %q", err, want)
+	}
+}
@@ -0,0 +1,137 @@
+package loader
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aep-lib-go/pkg/cases"
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+)
+
+// Enum is a string property restricted to a set of values,
+// declared with the "enum" key of its schema.
+type Enum struct {
+	// Pointer is the JSON pointer of the schema declaring
+	// the enum. For an array of enums, this is the pointer
+	// of its items.
+	Pointer string
+	// Schema is the name of the resource or shared schema
+	// the property belongs to, as keyed in NamedSchemas.
+	Schema string
+	// Resource reports whether Schema names a resource,
+	// rather than a shared schema.
+	Resource bool
+	// Path holds the names of the properties leading from
+	// Schema to the enumerated property, which is last.
+	Path []string
+	// Values are the allowed values, in declaration order.
+	Values []string
+}
+
+// Property returns the name of the enumerated property.
+func (e Enum) Property() string {
+	return e.Path[len(e.Path)-1]
+}
+
+// UnspecifiedValue returns the name of the value generated to
+// represent an unset enum, e.g. CONDITION_UNSPECIFIED.
+func (e Enum) UnspecifiedValue() string {
+	return strings.ToUpper(cases.KebabToSnakeCase(e.Property())) + "_UNSPECIFIED"
+}
+
+// SchemaPointer returns the JSON pointer of e relative to the
+// resource or shared schema it belongs to.
+func (e Enum) SchemaPointer() string {
+	if e.Resource {
+		return strings.TrimPrefix(e.Pointer, "/resources/"+escape(e.Schema)+"/schema")
+	}
+	return strings.TrimPrefix(e.Pointer, "/schemas/"+escape(e.Schema))
+}
+
+// Lookup returns the schema declaring e in a, or nil if there
+// is none.
+func (e Enum) Lookup(a *api.API) *openapi.Schema {
+	var s *openapi.Schema
+	if e.Resource {
+		if r, ok := a.Resources[e.Schema]; ok {
+			s = r.Schema
+		}
+	} else {
+		s = a.Schemas[e.Schema]
+	}
+	tokens := strings.Split(e.SchemaPointer(), "/")[1:]
+	for i := 0; s != nil && i < len(tokens); i++ {
+		switch tokens[i] {
+		case "items":
+			s = s.Items
+		case "properties":
+			i++
+			p, ok := s.Properties[unescape(tokens[i])]
+			if !ok {
+				return nil
+			}
+			s = &p
+		}
+	}
+	return s
+}
+
+// checkEnums returns the enums declared by the properties of
+// the resources and shared schemas in raw. Enums declared
+// anywhere else are reported as errors.
+func (c *checker) checkEnums(raw any) []Enum {
+	root, ok := raw.(map[string]any)
+	if !ok {
+		return nil
+	}
+	enums := []Enum{}
+	resources, _ := lookup(root, "resources").(map[string]any)
+	for _, name := range sortedKeys(resources) {
+		r, _ := resources[name].(map[string]any)
+		if s, ok := lookup(r, "schema").(map[string]any); ok {
+			walkEnums(&enums, "/resources/"+escape(name)+"/schema", Enum{Schema: name, Resource: true}, s)
+		}
+	}
+	schemas, _ := lookup(root, "schemas").(map[string]any)
+	for _, name := range sortedKeys(schemas) {
+		if s, ok := schemas[name].(map[string]any); ok {
+			walkEnums(&enums, "/schemas/"+escape(name), Enum{Schema: name}, s)
+		}
+	}
+	slices.SortFunc(enums, func(a, b Enum) int {
+		return strings.Compare(a.Pointer, b.Pointer)
+	})
+	for _, pointer := range c.enums {
+		if !slices.ContainsFunc(enums, func(e Enum) bool { return e.Pointer == pointer }) {
+			c.add(KindInvalid, pointer+"/enum", "enum is only supported on properties of resources and shared schemas")
+		}
+	}
+	return enums
+}
+
+// walkEnums appends the enums declared by s and its
+// properties, using owner to identify their schema.
+func walkEnums(enums *[]Enum, pointer string, owner Enum, s map[string]any) {
+	if values, ok := s["enum"].([]any); ok && len(owner.Path) > 0 {
+		e := owner
+		e.Pointer = pointer
+		for _, v := range values {
+			if v, ok := v.(string); ok {
+				e.Values = append(e.Values, v)
+			}
+		}
+		*enums = append(*enums, e)
+	}
+	properties, _ := lookup(s, "properties").(map[string]any)
+	for _, name := range sortedKeys(properties) {
+		if p, ok := properties[name].(map[string]any); ok {
+			child := owner
+			child.Path = append(slices.Clone(owner.Path), name)
+			walkEnums(enums, pointer+"/properties/"+escape(name), child, p)
+		}
+	}
+	if items, ok := lookup(s, "items").(map[string]any); ok {
+		walkEnums(enums, pointer+"/items", owner, items)
+	}
+}
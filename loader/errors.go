@@ -0,0 +1,71 @@
+package loader
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kind classifies an Error.
+type Kind string
+
+const (
+	// KindSyntax is returned for input that is not well-formed
+	// YAML or JSON.
+	KindSyntax Kind = "syntax"
+	// KindUnknownField is returned for a key that does not map
+	// to a field of the definition.
+	KindUnknownField Kind = "unknown-field"
+	// KindWrongType is returned for a value whose type does not
+	// match the field it is set on.
+	KindWrongType Kind = "wrong-type"
+	// KindMissingKey is returned when a required key is absent.
+	KindMissingKey Kind = "missing-key"
+	// KindInvalid is returned for a definition that is well-formed
+	// but cannot be loaded, e.g. an import that matches no files
+	// or an invalid version.
+	KindInvalid Kind = "invalid"
+)
+
+// Error is a failure to load a definition.
+type Error struct {
+	Kind Kind
+	// Pointer is the JSON pointer of the offending node, if
+	// known.
+	Pointer string
+	// Position of the offending node in the source, if known.
+	Position Position
+	Msg      string
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+	if pos := e.Position.String(); pos != "" {
+		b.WriteString(pos)
+		b.WriteString(": ")
+	}
+	b.WriteString(e.Msg)
+	if e.Pointer != "" {
+		fmt.Fprintf(&b, " (at %s)", e.Pointer)
+	}
+	return b.String()
+}
+
+// Errors is a list of load failures.
+type Errors []*Error
+
+func (errs Errors) Error() string {
+	msgs := []string{}
+	for _, e := range errs {
+		msgs = append(msgs, e.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap allows errors.As to match the individual errors.
+func (errs Errors) Unwrap() []error {
+	unwrapped := []error{}
+	for _, e := range errs {
+		unwrapped = append(unwrapped, e)
+	}
+	return unwrapped
+}
@@ -0,0 +1,171 @@
+package loader
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// importableKeys are the top-level keys an imported file may
+// declare. Each maps names to declarations, which are merged
+// into the importing definition.
+var importableKeys = []string{"resources", "schemas"}
+
+// importState tracks the files of a definition by absolute
+// path: those being imported, whose imports are being resolved,
+// and those already merged.
+type importState struct {
+	importing map[string]bool
+	merged    map[string]bool
+}
+
+func newImportState() *importState {
+	return &importState{importing: map[string]bool{}, merged: map[string]bool{}}
+}
+
+// resolveImports merges the resources and schemas of every
+// file listed under the "imports" key of raw into raw, and
+// their positions into src. Imports are resolved relative to
+// the directory of file, and may be glob patterns such as
+// "resources/*.yaml". Imported files may import other files.
+// A file imported by several files, such as shared schemas,
+// is merged once; a file importing itself, directly or through
+// other files, is an error.
+func resolveImports(file string, raw any, src SourceMap, state *importState) error {
+	root, ok := raw.(map[string]any)
+	if !ok {
+		return nil
+	}
+	imports, ok := root["imports"]
+	if !ok {
+		return nil
+	}
+	delete(root, "imports")
+	if abs, err := filepath.Abs(file); err == nil {
+		state.importing[abs] = true
+		defer delete(state.importing, abs)
+	}
+	patterns, ok := imports.([]any)
+	if !ok {
+		return newError(KindWrongType, src, "/imports", fmt.Sprintf("expected array, got %s", jsonType(imports)))
+	}
+	errs := Errors{}
+	for i, p := range patterns {
+		pointer := fmt.Sprintf("/imports/%d", i)
+		pattern, ok := p.(string)
+		if !ok {
+			errs = append(errs, newError(KindWrongType, src, pointer, fmt.Sprintf("expected string, got %s", jsonType(p))))
+			continue
+		}
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(filepath.Dir(file), pattern)
+		}
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			errs = append(errs, newError(KindInvalid, src, pointer, fmt.Sprintf("invalid import pattern %q: %v", p, err)))
+			continue
+		}
+		if len(matches) == 0 {
+			errs = append(errs, newError(KindInvalid, src, pointer, fmt.Sprintf("import %q matched no files", p)))
+			continue
+		}
+		for _, m := range matches {
+			abs, err := filepath.Abs(m)
+			if err != nil {
+				return err
+			}
+			if state.importing[abs] {
+				errs = append(errs, newError(KindInvalid, src, pointer, fmt.Sprintf("%q imports itself, directly or through other files", m)))
+				continue
+			}
+			if state.merged[abs] {
+				continue
+			}
+			state.merged[abs] = true
+			if err := importFile(m, root, src, state); err != nil {
+				if imported, ok := err.(Errors); ok {
+					errs = append(errs, imported...)
+				} else if imported, ok := err.(*Error); ok {
+					errs = append(errs, imported)
+				} else {
+					errs = append(errs, newError(KindInvalid, src, pointer, fmt.Sprintf("unable to import %q: %v", m, err)))
+				}
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// importFile loads file and merges its declarations into root.
+func importFile(file string, root map[string]any, src SourceMap, state *importState) error {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	raw, importedSrc, err := parseDocument(file, Format(file, b), b)
+	if err != nil {
+		return err
+	}
+	if err := resolveImports(file, raw, importedSrc, state); err != nil {
+		return err
+	}
+	imported, ok := raw.(map[string]any)
+	if !ok {
+		return newError(KindWrongType, importedSrc, "", fmt.Sprintf("expected object, got %s", jsonType(raw)))
+	}
+	errs := Errors{}
+	for _, k := range sortedKeys(imported) {
+		if !isImportable(k) {
+			errs = append(errs, newError(KindInvalid, importedSrc, "/"+escape(k),
+				fmt.Sprintf("imported files may only declare %s, found %q", strings.Join(importableKeys, " and "), k)))
+			continue
+		}
+		decls, ok := imported[k].(map[string]any)
+		if !ok {
+			errs = append(errs, newError(KindWrongType, importedSrc, "/"+escape(k), fmt.Sprintf("expected object, got %s", jsonType(imported[k]))))
+			continue
+		}
+		existing, _ := root[k].(map[string]any)
+		if existing == nil {
+			existing = map[string]any{}
+			root[k] = existing
+		}
+		for _, name := range sortedKeys(decls) {
+			pointer := "/" + k + "/" + escape(name)
+			if _, ok := existing[name]; ok {
+				prev, _ := src.Lookup(pointer)
+				errs = append(errs, newError(KindInvalid, importedSrc, pointer,
+					fmt.Sprintf("%s %q is already declared at %v", strings.TrimSuffix(k, "s"), name, prev)))
+				continue
+			}
+			existing[name] = decls[name]
+			for p, pos := range importedSrc {
+				if p == pointer || strings.HasPrefix(p, pointer+"/") {
+					src[p] = pos
+				}
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func isImportable(key string) bool {
+	for _, k := range importableKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+func newError(kind Kind, src SourceMap, pointer, msg string) *Error {
+	pos, _ := src.Lookup(pointer)
+	return &Error{Kind: kind, Pointer: pointer, Position: pos, Msg: msg}
+}
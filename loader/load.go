@@ -0,0 +1,390 @@
+package loader
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+	"github.com/ghodss/yaml"
+)
+
+// Options configure Load.
+type Options struct {
+	// Strict rejects keys that do not map to a field of the
+	// definition, rather than ignoring them.
+	Strict bool
+	// Format is the format of the root file, ".yaml" or
+	// ".json". If empty, it is taken from the extension of the
+	// file, or else from whether its content starts like a
+	// JSON document. Imported files are always detected.
+	Format string
+}
+
+// Definition is a loaded resource definition.
+type Definition struct {
+	API *api.API
+	// Source holds the position of every node of the
+	// definition, including the files it imports.
+	Source SourceMap
+	// Enums are the enumerated properties of the definition,
+	// ordered by pointer. They are kept apart from API, which
+	// has no representation for them.
+	Enums []Enum
+	// Lint is the x-aep-lint block of the definition, which
+	// configures the validator, as JSON. It is nil if the
+	// definition has none.
+	Lint json.RawMessage
+	// Version is the version of the API, such as v1 or
+	// v1beta2, or empty if the definition declares none.
+	Version string
+}
+
+// Load deserializes the resource definition in b, read from
+// file. Imports are resolved relative to the directory of file.
+//
+// Failures are returned as an *Error or as Errors.
+func Load(file string, b []byte, opts Options) (*Definition, error) {
+	format := opts.Format
+	if format == "" {
+		format = Format(file, b)
+	}
+	raw, src, err := parseDocument(file, format, b)
+	if err != nil {
+		return nil, err
+	}
+	if err := resolveImports(file, raw, src, newImportState()); err != nil {
+		return nil, err
+	}
+	c := &checker{src: src, strict: opts.Strict}
+	c.checkShape("", raw, reflect.TypeOf(api.API{}))
+	c.checkRequired(raw)
+	enums := c.checkEnums(raw)
+	version := c.checkVersion(raw)
+	if len(c.errs) > 0 {
+		return nil, c.errs
+	}
+	asJSON, err := json.Marshal(raw)
+	if err != nil {
+		return nil, &Error{Kind: KindSyntax, Position: Position{File: file}, Msg: err.Error()}
+	}
+	a := &api.API{}
+	if err := json.Unmarshal(asJSON, a); err != nil {
+		return nil, &Error{Kind: KindWrongType, Position: Position{File: file}, Msg: err.Error()}
+	}
+	// parents that are not declared are reported by the
+	// validator, with their position, rather than failing the
+	// load. Its rule cannot be disabled, so such a definition
+	// never reaches the generators.
+	unresolved := map[*api.Resource][]string{}
+	for _, r := range a.Resources {
+		parents := []string{}
+		for _, p := range r.Parents {
+			if _, ok := a.Resources[p]; ok {
+				parents = append(parents, p)
+			}
+		}
+		if len(parents) != len(r.Parents) {
+			unresolved[r] = r.Parents
+			r.Parents = parents
+		}
+	}
+	if err := api.AddImplicitFieldsAndValidate(a); err != nil {
+		return nil, &Error{Kind: KindInvalid, Position: Position{File: file}, Msg: err.Error()}
+	}
+	for r, parents := range unresolved {
+		r.Parents = parents
+	}
+	d := &Definition{API: a, Source: src, Enums: enums, Version: version}
+	if root, ok := raw.(map[string]any); ok && root[lintKey] != nil {
+		d.Lint, _ = json.Marshal(root[lintKey])
+	}
+	return d, nil
+}
+
+// Format returns the format of b, read from file: ".json" or
+// ".yaml", as given by the extension of file, or for other
+// extensions, by whether b starts like a JSON document.
+func Format(file string, b []byte) string {
+	switch extension(file) {
+	case ".yaml", ".yml":
+		return ".yaml"
+	case ".json":
+		return ".json"
+	}
+	if trimmed := bytes.TrimLeft(b, " \t\r\n"); len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		return ".json"
+	}
+	return ".yaml"
+}
+
+// parseDocument decodes b, in the format given by ext, into
+// generic JSON values, along with the position of each node.
+func parseDocument(file, ext string, b []byte) (any, SourceMap, error) {
+	src, err := BuildSourceMap(file, ext, b)
+	if err != nil {
+		return nil, nil, err
+	}
+	asJSON := b
+	if ext == ".yaml" {
+		asJSON, err = yaml.YAMLToJSON(b)
+		if err != nil {
+			return nil, nil, &Error{Kind: KindSyntax, Position: Position{File: file}, Msg: err.Error()}
+		}
+	}
+	var raw any
+	if err := json.Unmarshal(asJSON, &raw); err != nil {
+		return nil, nil, &Error{Kind: KindSyntax, Position: Position{File: file}, Msg: err.Error()}
+	}
+	return raw, src, nil
+}
+
+// extension returns the extension of file.
+func extension(file string) string {
+	i := strings.LastIndex(file, ".")
+	if i < 0 || strings.ContainsAny(file[i:], `/\`) {
+		return ""
+	}
+	return file[i:]
+}
+
+type checker struct {
+	src    SourceMap
+	strict bool
+	errs   Errors
+	// enums holds the pointer of every schema declaring an
+	// enum, wherever it appears.
+	enums []string
+}
+
+func (c *checker) add(kind Kind, pointer, msg string) {
+	c.errs = append(c.errs, newError(kind, c.src, pointer, msg))
+}
+
+var rawMessageType = reflect.TypeOf(json.RawMessage{})
+
+// lintKey is the key of the block configuring the validator.
+const lintKey = "x-aep-lint"
+
+// versionKey is the key of the version of the API.
+const versionKey = "version"
+
+// extraFields are the keys accepted on a struct in addition
+// to its JSON fields, along with the type of their value.
+var extraFields = map[reflect.Type]map[string]reflect.Type{
+	reflect.TypeOf(api.API{}): {
+		lintKey:    rawMessageType,
+		versionKey: reflect.TypeOf(""),
+	},
+	reflect.TypeOf(openapi.Schema{}): {
+		"enum": reflect.TypeOf([]string{}),
+	},
+}
+
+// checkShape verifies that v, decoded from JSON, can be
+// unmarshalled into t. Unlike encoding/json, it reports every
+// mismatch along with its JSON pointer.
+func (c *checker) checkShape(pointer string, v any, t reflect.Type) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if v == nil || t == rawMessageType {
+		return
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		m, ok := v.(map[string]any)
+		if !ok {
+			c.wrongType(pointer, "object", v)
+			return
+		}
+		for _, k := range sortedKeys(m) {
+			if et, ok := extraFields[t][k]; ok {
+				if k == "enum" {
+					c.enums = append(c.enums, pointer)
+				}
+				c.checkShape(pointer+"/"+escape(k), m[k], et)
+				continue
+			}
+			f, ok := jsonField(t, k)
+			if !ok {
+				if c.strict {
+					c.add(KindUnknownField, pointer+"/"+escape(k), fmt.Sprintf("unknown field %q", k))
+				}
+				continue
+			}
+			c.checkShape(pointer+"/"+escape(k), m[k], f.Type)
+		}
+	case reflect.Map:
+		m, ok := v.(map[string]any)
+		if !ok {
+			c.wrongType(pointer, "object", v)
+			return
+		}
+		for _, k := range sortedKeys(m) {
+			c.checkShape(pointer+"/"+escape(k), m[k], t.Elem())
+		}
+	case reflect.Slice:
+		l, ok := v.([]any)
+		if !ok {
+			c.wrongType(pointer, "array", v)
+			return
+		}
+		for i, e := range l {
+			c.checkShape(fmt.Sprintf("%s/%d", pointer, i), e, t.Elem())
+		}
+	case reflect.String:
+		if _, ok := v.(string); !ok {
+			c.wrongType(pointer, "string", v)
+		}
+	case reflect.Bool:
+		if _, ok := v.(bool); !ok {
+			c.wrongType(pointer, "boolean", v)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if f, ok := v.(float64); !ok || f != math.Trunc(f) {
+			c.wrongType(pointer, "integer", v)
+		}
+	case reflect.Float32, reflect.Float64:
+		if _, ok := v.(float64); !ok {
+			c.wrongType(pointer, "number", v)
+		}
+	}
+}
+
+func (c *checker) wrongType(pointer, want string, v any) {
+	c.add(KindWrongType, pointer, fmt.Sprintf("expected %s, got %s", want, jsonType(v)))
+}
+
+// checkRequired verifies that the keys the generators depend
+// on are present.
+func (c *checker) checkRequired(raw any) {
+	root, ok := raw.(map[string]any)
+	if !ok {
+		return
+	}
+	c.require("", root, "name")
+	resources, _ := lookup(root, "resources").(map[string]any)
+	for _, name := range sortedKeys(resources) {
+		r, ok := resources[name].(map[string]any)
+		if !ok {
+			continue
+		}
+		rPointer := "/resources/" + escape(name)
+		c.require(rPointer, r, "singular", "plural", "schema")
+		cms, _ := lookup(r, "custom_methods").([]any)
+		for i, cm := range cms {
+			if cm, ok := cm.(map[string]any); ok {
+				c.require(fmt.Sprintf("%s/custom_methods/%d", rPointer, i), cm, "name", "method")
+			}
+		}
+	}
+}
+
+// versionPattern matches the major version of an API, followed
+// by an optional stability channel, e.g. v1, v2alpha or v1beta2.
+var versionPattern = regexp.MustCompile(`^v[0-9]+((alpha|beta)[0-9]*)?$`)
+
+// checkVersion returns the version of the definition, if it
+// declares a valid one.
+func (c *checker) checkVersion(raw any) string {
+	root, ok := raw.(map[string]any)
+	if !ok {
+		return ""
+	}
+	version, ok := root[versionKey].(string)
+	if !ok {
+		return ""
+	}
+	if !versionPattern.MatchString(version) {
+		c.add(KindInvalid, "/"+versionKey, fmt.Sprintf("invalid version %q, must be a major version with an optional channel, e.g. v1 or v1beta2", version))
+		return ""
+	}
+	return version
+}
+
+func (c *checker) require(pointer string, m map[string]any, keys ...string) {
+	for _, k := range keys {
+		if lookup(m, k) == nil {
+			c.add(KindMissingKey, pointer, fmt.Sprintf("missing required key %q", k))
+		}
+	}
+}
+
+// lookup returns the value of key in m, matching keys
+// case-insensitively like encoding/json.
+func lookup(m map[string]any, key string) any {
+	if v, ok := m[key]; ok {
+		return v
+	}
+	for k, v := range m {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return nil
+}
+
+// jsonField returns the field of struct t that encoding/json
+// would unmarshal key into.
+func jsonField(t reflect.Type, key string) (reflect.StructField, bool) {
+	var fold reflect.StructField
+	found := false
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := f.Name
+		if tag, ok := f.Tag.Lookup("json"); ok {
+			tagName := strings.Split(tag, ",")[0]
+			if tagName == "-" {
+				continue
+			}
+			if tagName != "" {
+				name = tagName
+			}
+		}
+		if name == key {
+			return f, true
+		}
+		if !found && strings.EqualFold(name, key) {
+			fold = f
+			found = true
+		}
+	}
+	return fold, found
+}
+
+func jsonType(v any) string {
+	switch v.(type) {
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := []string{}
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
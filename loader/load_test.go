@@ -0,0 +1,311 @@
+package loader
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	input := `name: "bookstore.example.com"
+version: v1
+server_url: "http://localhost:8081"
+resources:
+  book:
+    singular: "book"
+    plural: "books"
+    schema:
+      type: object
+      properties:
+        price:
+          type: integer
+          format: int32
+          x-aep-field:
+            field_number: 1
+    methods:
+      get: {}
+`
+	d, err := Load("bookstore.yaml", []byte(input), Options{Strict: true})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	a, src := d.API, d.Source
+	if a.Name != "bookstore.example.com" {
+		t.Errorf("Load() name = %q, want %q", a.Name, "bookstore.example.com")
+	}
+	if _, ok := a.Resources["book"].Schema.Properties["path"]; !ok {
+		t.Errorf("Load() did not add the implicit path field")
+	}
+	if pos, _ := src.Lookup("/resources/book/methods/get"); pos.Line != 17 {
+		t.Errorf("Lookup() = %v, want line 17", pos)
+	}
+	if d.Version != "v1" {
+		t.Errorf("Load() version = %q, want %q", d.Version, "v1")
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		input   string
+		opts    Options
+		kind    Kind
+		pointer string
+	}{
+		{
+			name: "wrong type",
+			file: "f.yaml",
+			input: `name: x
+resources:
+  book:
+    singular: book
+    plural: books
+    schema:
+      properties:
+        price:
+          x-aep-field:
+            field_number: two
+`,
+			kind:    KindWrongType,
+			pointer: "/resources/book/schema/properties/price/x-aep-field/field_number",
+		},
+		{
+			name:    "missing key",
+			file:    "f.json",
+			input:   `{"name": "x", "resources": {"book": {"singular": "book", "schema": {}}}}`,
+			kind:    KindMissingKey,
+			pointer: "/resources/book",
+		},
+		{
+			name:    "unknown field when strict",
+			file:    "f.json",
+			input:   `{"name": "x", "colour": "blue"}`,
+			opts:    Options{Strict: true},
+			kind:    KindUnknownField,
+			pointer: "/colour",
+		},
+		{
+			name: "invalid plural",
+			file: "f.yaml",
+			input: `name: x
+resources:
+  book:
+    singular: book
+    plural: Books
+    schema: {}
+`,
+			kind: KindInvalid,
+		},
+		{
+			name:    "invalid version",
+			file:    "f.json",
+			input:   `{"name": "x", "version": "1.0"}`,
+			kind:    KindInvalid,
+			pointer: "/version",
+		},
+		{
+			name:    "version of the wrong type",
+			file:    "f.json",
+			input:   `{"name": "x", "version": 1}`,
+			kind:    KindWrongType,
+			pointer: "/version",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(tt.file, []byte(tt.input), tt.opts)
+			var loadErr *Error
+			if !errors.As(err, &loadErr) {
+				t.Fatalf("Load() error = %v, want a *Error", err)
+			}
+			if loadErr.Kind != tt.kind {
+				t.Errorf("Load() kind = %v, want %v", loadErr.Kind, tt.kind)
+			}
+			if loadErr.Pointer != tt.pointer {
+				t.Errorf("Load() pointer = %q, want %q", loadErr.Pointer, tt.pointer)
+			}
+			if strings.Contains(err.Error(), tt.input) {
+				t.Errorf("Load() error echoes the input: %v", err)
+			}
+		})
+	}
+}
+
+func TestLoadImports(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "api.yaml"), `name: "bookstore.example.com"
+imports: ["resources/*.yaml"]
+resources:
+  publisher:
+    singular: publisher
+    plural: publishers
+    schema: {}
+`)
+	writeFile(t, filepath.Join(dir, "resources", "book.yaml"), `resources:
+  book:
+    singular: book
+    plural: books
+    parents: ["publisher"]
+    schema: {}
+`)
+	d, err := loadFile(t, filepath.Join(dir, "api.yaml"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if _, ok := d.API.Resources["book"]; !ok {
+		t.Errorf("Load() did not import resource book")
+	}
+	pos, _ := d.Source.Lookup("/resources/book/parents")
+	if want := filepath.Join(dir, "resources", "book.yaml"); pos.File != want || pos.Line != 5 {
+		t.Errorf("Lookup() = %v, want %s:5", pos, want)
+	}
+
+	writeFile(t, filepath.Join(dir, "resources", "duplicate.yaml"), `resources:
+  publisher:
+    singular: publisher
+    plural: publishers
+    schema: {}
+`)
+	_, err = loadFile(t, filepath.Join(dir, "api.yaml"))
+	var loadErr *Error
+	if !errors.As(err, &loadErr) || loadErr.Kind != KindInvalid || loadErr.Pointer != "/resources/publisher" {
+		t.Errorf("Load() error = %v, want duplicate resource error", err)
+	}
+}
+
+func TestLoadSharedImports(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "api.yaml"), `name: "bookstore.example.com"
+imports: ["book.yaml", "shelf.yaml"]
+`)
+	for name, plural := range map[string]string{"book": "books", "shelf": "shelves"} {
+		writeFile(t, filepath.Join(dir, name+".yaml"), `imports: ["common.yaml"]
+resources:
+  `+name+`:
+    singular: `+name+`
+    plural: `+plural+`
+    schema:
+      properties:
+        price: {$ref: "#/components/schemas/money"}
+`)
+	}
+	writeFile(t, filepath.Join(dir, "common.yaml"), `schemas:
+  money:
+    type: object
+    properties:
+      units: {type: integer, format: int64}
+`)
+	d, err := loadFile(t, filepath.Join(dir, "api.yaml"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if _, ok := d.API.Schemas["money"]; !ok || len(d.API.Resources) != 2 {
+		t.Errorf("Load() = %v resources and schemas %v, want book, shelf and money", d.API.Resources, d.API.Schemas)
+	}
+
+	writeFile(t, filepath.Join(dir, "common.yaml"), `imports: ["shelf.yaml"]
+`)
+	_, err = loadFile(t, filepath.Join(dir, "api.yaml"))
+	var loadErr *Error
+	if !errors.As(err, &loadErr) || !strings.Contains(loadErr.Msg, "imports itself") {
+		t.Errorf("Load() error = %v, want an import cycle", err)
+	}
+}
+
+func TestLoadEnums(t *testing.T) {
+	input := `name: x
+resources:
+  item:
+    singular: item
+    plural: items
+    schema:
+      properties:
+        condition:
+          type: string
+          enum: ["NEW", "USED"]
+        labels:
+          type: array
+          items:
+            type: string
+            enum: ["SALE"]
+`
+	d, err := Load("f.yaml", []byte(input), Options{Strict: true})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	want := []Enum{
+		{Pointer: "/resources/item/schema/properties/condition", Schema: "item", Resource: true, Path: []string{"condition"}, Values: []string{"NEW", "USED"}},
+		{Pointer: "/resources/item/schema/properties/labels/items", Schema: "item", Resource: true, Path: []string{"labels"}, Values: []string{"SALE"}},
+	}
+	if !reflect.DeepEqual(d.Enums, want) {
+		t.Errorf("Load() enums = %+v, want %+v", d.Enums, want)
+	}
+	if s := d.Enums[1].Lookup(d.API); s == nil || s.Type != "string" {
+		t.Errorf("Lookup() = %+v, want the items schema", s)
+	}
+	if got := d.Enums[0].UnspecifiedValue(); got != "CONDITION_UNSPECIFIED" {
+		t.Errorf("UnspecifiedValue() = %q, want %q", got, "CONDITION_UNSPECIFIED")
+	}
+
+	_, err = Load("f.yaml", []byte(input+"schemas:\n  color:\n    type: string\n    enum: [\"RED\"]\n"), Options{})
+	var loadErr *Error
+	if !errors.As(err, &loadErr) || loadErr.Kind != KindInvalid || loadErr.Pointer != "/schemas/color/enum" {
+		t.Errorf("Load() error = %v, want unsupported enum error", err)
+	}
+}
+
+func writeFile(t *testing.T, path, contents string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func loadFile(t *testing.T, path string) (*Definition, error) {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Load(path, b, Options{})
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		file, input, want string
+	}{
+		{"api.yaml", `{"name": "x"}`, ".yaml"},
+		{"api.yml", "name: x", ".yaml"},
+		{"api.json", "name: x", ".json"},
+		{"api.txt", "  \n{\"name\": \"x\"}", ".json"},
+		{"<stdin>", "[]", ".json"},
+		{"<stdin>", "name: x", ".yaml"},
+		{"dir.d/api", "", ".yaml"},
+	}
+	for _, tt := range tests {
+		if got := Format(tt.file, []byte(tt.input)); got != tt.want {
+			t.Errorf("Format(%q, %q) = %q, want %q", tt.file, tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestLoadFormat(t *testing.T) {
+	// the format overrides the extension of the file.
+	d, err := Load("api.txt", []byte(`{"name": "x", "version": "v2"}`), Options{Strict: true, Format: ".yaml"})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if d.API.Name != "x" || d.Version != "v2" {
+		t.Errorf("Load() = %q %q, want x v2", d.API.Name, d.Version)
+	}
+	if _, err := Load("api.yaml", []byte("name: x"), Options{Format: ".json"}); err == nil {
+		t.Errorf("Load() of YAML as JSON succeeded, want an error")
+	}
+}
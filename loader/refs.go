@@ -0,0 +1,133 @@
+package loader
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+)
+
+// SchemaRefPrefix prefixes references to the shared schemas
+// declared under the top-level "schemas" key of a definition.
+const SchemaRefPrefix = "#/components/schemas/"
+
+// SchemaRef is a reference from a schema to a shared schema.
+type SchemaRef struct {
+	// Pointer is the JSON pointer of the referencing schema,
+	// relative to the schema that was walked.
+	Pointer string
+	// Name of the referenced schema.
+	Name string
+}
+
+// SchemaRefs returns the local references made by s, its
+// properties and its items. References to external documents
+// are ignored.
+func SchemaRefs(s *openapi.Schema) []SchemaRef {
+	refs := []SchemaRef{}
+	walkSchemaRefs("", s, &refs)
+	return refs
+}
+
+func walkSchemaRefs(pointer string, s *openapi.Schema, refs *[]SchemaRef) {
+	if s == nil {
+		return
+	}
+	if name, ok := strings.CutPrefix(s.Ref, SchemaRefPrefix); ok {
+		*refs = append(*refs, SchemaRef{Pointer: pointer, Name: name})
+	}
+	names := []string{}
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		p := s.Properties[name]
+		walkSchemaRefs(pointer+"/properties/"+escape(name), &p, refs)
+	}
+	walkSchemaRefs(pointer+"/items", s.Items, refs)
+}
+
+// NamedSchemas returns every schema in a that can be the
+// target of a reference: the shared schemas and the schema of
+// each resource, keyed by name.
+func NamedSchemas(a *api.API) map[string]*openapi.Schema {
+	schemas := map[string]*openapi.Schema{}
+	for name, s := range a.Schemas {
+		schemas[name] = s
+	}
+	for name, r := range a.Resources {
+		schemas[name] = r.Schema
+	}
+	return schemas
+}
+
+// RefCycleError is returned by SchemaOrder when schemas
+// reference each other in a cycle.
+type RefCycleError struct {
+	// Cycle lists the schemas in the cycle, starting and
+	// ending with the same schema.
+	Cycle []string
+}
+
+func (e *RefCycleError) Error() string {
+	return fmt.Sprintf("schema reference cycle: %s", strings.Join(e.Cycle, " -> "))
+}
+
+// SchemaOrder returns the names of NamedSchemas(a), ordered
+// such that every schema comes after the schemas it
+// references. Ties are broken alphabetically. References to
+// unknown schemas are ignored.
+func SchemaOrder(a *api.API) ([]string, error) {
+	schemas := NamedSchemas(a)
+	names := []string{}
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := map[string]int{}
+	order := []string{}
+	stack := []string{}
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case done:
+			return nil
+		case visiting:
+			i := 0
+			for stack[i] != name {
+				i++
+			}
+			cycle := append(append([]string{}, stack[i:]...), name)
+			return &RefCycleError{Cycle: cycle}
+		}
+		state[name] = visiting
+		stack = append(stack, name)
+		for _, ref := range SchemaRefs(schemas[name]) {
+			if _, ok := schemas[ref.Name]; !ok {
+				continue
+			}
+			if err := visit(ref.Name); err != nil {
+				return err
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[name] = done
+		order = append(order, name)
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
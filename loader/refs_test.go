@@ -0,0 +1,49 @@
+package loader
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+)
+
+func TestSchemaOrder(t *testing.T) {
+	ref := func(name string) openapi.Schema {
+		return openapi.Schema{Ref: SchemaRefPrefix + name}
+	}
+	a := &api.API{
+		Schemas: map[string]*openapi.Schema{
+			"money": {Type: "object"},
+			"address": {Type: "object", Properties: openapi.Properties{
+				"owner": {Type: "array", Items: &openapi.Schema{Ref: SchemaRefPrefix + "person"}},
+			}},
+			"person": {Type: "object"},
+		},
+		Resources: map[string]*api.Resource{
+			"book": {Schema: &openapi.Schema{Properties: openapi.Properties{
+				"price":   ref("money"),
+				"shipped": ref("address"),
+			}}},
+		},
+	}
+	got, err := SchemaOrder(a)
+	if err != nil {
+		t.Fatalf("SchemaOrder() error = %v", err)
+	}
+	want := []string{"person", "address", "money", "book"}
+	if !slices.Equal(got, want) {
+		t.Errorf("SchemaOrder() = %v, want %v", got, want)
+	}
+
+	a.Schemas["person"].Properties = openapi.Properties{"home": ref("address")}
+	_, err = SchemaOrder(a)
+	var cycleErr *RefCycleError
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("SchemaOrder() error = %v, want a *RefCycleError", err)
+	}
+	if want := []string{"address", "person", "address"}; !slices.Equal(cycleErr.Cycle, want) {
+		t.Errorf("SchemaOrder() cycle = %v, want %v", cycleErr.Cycle, want)
+	}
+}
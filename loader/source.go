@@ -0,0 +1,250 @@
+// Package loader reads resource definitions, retaining the
+// position of every node in the original input so that
+// diagnostics can point at the line they were found on.
+package loader
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Position is a location in a source file. Line and Column
+// are 1-based; a zero Line means the position is unknown.
+type Position struct {
+	File   string
+	Line   int
+	Column int
+}
+
+func (p Position) String() string {
+	if p.Line == 0 {
+		return p.File
+	}
+	return fmt.Sprintf("%s:%d:%d", p.File, p.Line, p.Column)
+}
+
+// SourceMap maps JSON pointers into a definition to the
+// position they were declared at.
+type SourceMap map[string]Position
+
+// Lookup returns the position of pointer. If pointer was not
+// declared in the source (e.g. it refers to an implicit field),
+// the position of its closest declared ancestor is returned.
+func (m SourceMap) Lookup(pointer string) (Position, bool) {
+	for {
+		if p, ok := m[pointer]; ok {
+			return p, true
+		}
+		i := strings.LastIndex(pointer, "/")
+		if i < 0 {
+			return Position{}, false
+		}
+		pointer = pointer[:i]
+	}
+}
+
+// Pointers returns the sorted pointers in the map.
+func (m SourceMap) Pointers() []string {
+	ps := []string{}
+	for p := range m {
+		ps = append(ps, p)
+	}
+	sort.Strings(ps)
+	return ps
+}
+
+// BuildSourceMap parses b in the format given by ext (".yaml"
+// or ".json") and returns the position of every node.
+func BuildSourceMap(file, ext string, b []byte) (SourceMap, error) {
+	switch ext {
+	case ".yaml":
+		return yamlSourceMap(file, b)
+	case ".json":
+		return jsonSourceMap(file, b)
+	default:
+		return nil, fmt.Errorf("extension %v is unsupported", ext)
+	}
+}
+
+func yamlSourceMap(file string, b []byte) (SourceMap, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, yamlSyntaxError(file, err)
+	}
+	m := SourceMap{}
+	if len(doc.Content) > 0 {
+		walkYAML(m, file, "", doc.Content[0])
+	}
+	return m, nil
+}
+
+// walkYAML records the position of n at pointer, and recurses
+// into its children. Mapping values are recorded at the
+// position of their key, which is where readers expect the
+// declaration to be.
+func walkYAML(m SourceMap, file, pointer string, n *yaml.Node) {
+	if _, ok := m[pointer]; !ok {
+		m[pointer] = Position{File: file, Line: n.Line, Column: n.Column}
+	}
+	switch n.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k, v := n.Content[i], n.Content[i+1]
+			child := pointer + "/" + escape(k.Value)
+			m[child] = Position{File: file, Line: k.Line, Column: k.Column}
+			walkYAML(m, file, child, v)
+		}
+	case yaml.SequenceNode:
+		for i, v := range n.Content {
+			walkYAML(m, file, pointer+"/"+strconv.Itoa(i), v)
+		}
+	case yaml.AliasNode:
+		if n.Alias != nil {
+			walkYAML(m, file, pointer, n.Alias)
+		}
+	}
+}
+
+// yamlSyntaxError converts the "yaml: line N: msg" errors
+// returned by yaml.v3 into an Error.
+func yamlSyntaxError(file string, err error) error {
+	msg := strings.TrimPrefix(err.Error(), "yaml: ")
+	line := 0
+	if strings.HasPrefix(msg, "line ") {
+		rest := strings.TrimPrefix(msg, "line ")
+		if i := strings.Index(rest, ": "); i > 0 {
+			if n, convErr := strconv.Atoi(rest[:i]); convErr == nil {
+				line = n
+				msg = rest[i+2:]
+			}
+		}
+	}
+	col := 0
+	if line > 0 {
+		col = 1
+	}
+	return &Error{Kind: KindSyntax, Position: Position{File: file, Line: line, Column: col}, Msg: msg}
+}
+
+func jsonSourceMap(file string, b []byte) (SourceMap, error) {
+	w := &jsonWalker{
+		file:  file,
+		b:     b,
+		dec:   json.NewDecoder(bytes.NewReader(b)),
+		lines: lineOffsets(b),
+		m:     SourceMap{},
+	}
+	if err := w.value(""); err != nil {
+		return nil, err
+	}
+	return w.m, nil
+}
+
+type jsonWalker struct {
+	file  string
+	b     []byte
+	dec   *json.Decoder
+	lines []int
+	m     SourceMap
+}
+
+// next returns the next token, along with the position it
+// starts at.
+func (w *jsonWalker) next() (json.Token, Position, error) {
+	start := w.skipSeparators(int(w.dec.InputOffset()))
+	pos := w.position(start)
+	t, err := w.dec.Token()
+	if err != nil {
+		if err == io.EOF {
+			return nil, pos, &Error{Kind: KindSyntax, Position: pos, Msg: "unexpected end of JSON input"}
+		}
+		return nil, pos, &Error{Kind: KindSyntax, Position: pos, Msg: err.Error()}
+	}
+	return t, pos, nil
+}
+
+func (w *jsonWalker) value(pointer string) error {
+	t, pos, err := w.next()
+	if err != nil {
+		return err
+	}
+	if _, ok := w.m[pointer]; !ok {
+		w.m[pointer] = pos
+	}
+	return w.children(pointer, t)
+}
+
+func (w *jsonWalker) children(pointer string, t json.Token) error {
+	switch t {
+	case json.Delim('{'):
+		for w.dec.More() {
+			k, pos, err := w.next()
+			if err != nil {
+				return err
+			}
+			child := pointer + "/" + escape(k.(string))
+			w.m[child] = pos
+			if err := w.value(child); err != nil {
+				return err
+			}
+		}
+		_, _, err := w.next()
+		return err
+	case json.Delim('['):
+		for i := 0; w.dec.More(); i++ {
+			if err := w.value(pointer + "/" + strconv.Itoa(i)); err != nil {
+				return err
+			}
+		}
+		_, _, err := w.next()
+		return err
+	}
+	return nil
+}
+
+// skipSeparators returns the offset of the first byte at or
+// after offset that is not whitespace or a JSON separator.
+func (w *jsonWalker) skipSeparators(offset int) int {
+	for offset < len(w.b) {
+		switch w.b[offset] {
+		case ' ', '\t', '\r', '\n', ',', ':':
+			offset++
+		default:
+			return offset
+		}
+	}
+	return offset
+}
+
+func (w *jsonWalker) position(offset int) Position {
+	line := sort.Search(len(w.lines), func(i int) bool { return w.lines[i] > offset })
+	return Position{File: w.file, Line: line, Column: offset - w.lines[line-1] + 1}
+}
+
+// lineOffsets returns the offset that each line starts at.
+func lineOffsets(b []byte) []int {
+	offsets := []int{0}
+	for i, c := range b {
+		if c == '\n' {
+			offsets = append(offsets, i+1)
+		}
+	}
+	return offsets
+}
+
+func escape(token string) string {
+	token = strings.ReplaceAll(token, "~", "~0")
+	return strings.ReplaceAll(token, "/", "~1")
+}
+
+func unescape(token string) string {
+	token = strings.ReplaceAll(token, "~1", "/")
+	return strings.ReplaceAll(token, "~0", "~")
+}
@@ -0,0 +1,117 @@
+package loader
+
+import (
+	"testing"
+)
+
+func TestBuildSourceMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		ext     string
+		input   string
+		pointer string
+		want    Position
+	}{
+		{
+			name: "yaml property",
+			ext:  ".yaml",
+			input: `name: "bookstore.example.com"
+resources:
+  book:
+    schema:
+      properties:
+        price:
+          type: integer
+`,
+			pointer: "/resources/book/schema/properties/price",
+			want:    Position{File: "f", Line: 6, Column: 9},
+		},
+		{
+			name: "yaml sequence",
+			ext:  ".yaml",
+			input: `parents:
+  - publisher
+  - store
+`,
+			pointer: "/parents/1",
+			want:    Position{File: "f", Line: 3, Column: 5},
+		},
+		{
+			name: "yaml undeclared falls back to parent",
+			ext:  ".yaml",
+			input: `resources:
+  book:
+    singular: book
+`,
+			pointer: "/resources/book/schema/properties/path",
+			want:    Position{File: "f", Line: 2, Column: 3},
+		},
+		{
+			name: "json property",
+			ext:  ".json",
+			input: `{
+  "name": "bookstore.example.com",
+  "resources": {
+    "book": {"plural": "books"}
+  }
+}`,
+			pointer: "/resources/book/plural",
+			want:    Position{File: "f", Line: 4, Column: 14},
+		},
+		{
+			name:    "json sequence",
+			ext:     ".json",
+			input:   `{"parents": ["publisher", "store"]}`,
+			pointer: "/parents/1",
+			want:    Position{File: "f", Line: 1, Column: 27},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := BuildSourceMap("f", tt.ext, []byte(tt.input))
+			if err != nil {
+				t.Fatalf("BuildSourceMap() error = %v", err)
+			}
+			got, ok := m.Lookup(tt.pointer)
+			if !ok {
+				t.Fatalf("Lookup(%q) not found", tt.pointer)
+			}
+			if got != tt.want {
+				t.Errorf("Lookup(%q) = %v, want %v", tt.pointer, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildSourceMapSyntaxError(t *testing.T) {
+	tests := []struct {
+		name  string
+		ext   string
+		input string
+		want  string
+	}{
+		{
+			name:  "yaml",
+			ext:   ".yaml",
+			input: "name: x\n  bad: [\n",
+			want:  "f:2:1: mapping values are not allowed in this context",
+		},
+		{
+			name:  "json",
+			ext:   ".json",
+			input: "{\n  \"name\": }",
+			want:  "f:2:11: missing value after object key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := BuildSourceMap("f", tt.ext, []byte(tt.input))
+			if err == nil {
+				t.Fatalf("BuildSourceMap() expected error")
+			}
+			if err.Error() != tt.want {
+				t.Errorf("BuildSourceMap() error = %q, want %q", err.Error(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+// Package lock maintains the lockfile of an API: every message
+// and field number ever generated for it, so that the numbers
+// of removed fields are never reused.
+package lock
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/ghodss/yaml"
+)
+
+// File is the name of the lockfile, written next to the
+// definition.
+const File = "aepc.lock"
+
+const header = "# Code generated by aepc. DO NOT EDIT.\n"
+
+// Lock records the fields of each generated message, keyed by
+// the message name relative to the proto package, e.g. "Book"
+// or "Item.Details".
+type Lock struct {
+	Messages map[string]*Message `json:"messages"`
+}
+
+// Message records the fields of a message.
+type Message struct {
+	// Fields maps the name of each current field to its
+	// number.
+	Fields map[string]int32 `json:"fields,omitempty"`
+	// Retired are the fields that were removed from the
+	// message, sorted by number. Neither their names nor
+	// their numbers may be used again.
+	Retired []Field `json:"retired,omitempty"`
+}
+
+// Field is a retired field.
+type Field struct {
+	Name   string `json:"name"`
+	Number int32  `json:"number"`
+}
+
+// New returns an empty lock.
+func New() *Lock {
+	return &Lock{Messages: map[string]*Message{}}
+}
+
+// Parse parses a lock.
+func Parse(b []byte) (*Lock, error) {
+	l := New()
+	if err := yaml.Unmarshal(b, l); err != nil {
+		return nil, err
+	}
+	if l.Messages == nil {
+		l.Messages = map[string]*Message{}
+	}
+	for name, m := range l.Messages {
+		if m == nil {
+			l.Messages[name] = &Message{}
+		}
+	}
+	return l, nil
+}
+
+// Read reads the lock at path. A missing file is an empty lock.
+func Read(path string) (*Lock, error) {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return New(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	l, err := Parse(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return l, nil
+}
+
+// Marshal serializes l, with messages and fields sorted by
+// name.
+func (l *Lock) Marshal() ([]byte, error) {
+	b, err := yaml.Marshal(l)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(header), b...), nil
+}
+
+// Update checks messages, the fields of the messages currently
+// generated keyed like Lock.Messages, against l, then records
+// them. A field keeps its number for as long as it exists, and
+// once removed, neither its name nor its number may be used
+// again in the same message. Fields that are no longer
+// generated are retired. On error, l is left unmodified.
+func (l *Lock) Update(messages map[string]map[string]int32) error {
+	errs := []error{}
+	for _, msg := range sortedKeys(messages) {
+		locked, ok := l.Messages[msg]
+		if !ok {
+			continue
+		}
+		for _, name := range sortedKeys(messages[msg]) {
+			if err := locked.check(msg, name, messages[msg][name]); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	for _, msg := range sortedKeys(l.Messages) {
+		locked := l.Messages[msg]
+		for _, name := range sortedKeys(locked.Fields) {
+			if _, ok := messages[msg][name]; !ok {
+				locked.Retired = append(locked.Retired, Field{Name: name, Number: locked.Fields[name]})
+				delete(locked.Fields, name)
+			}
+		}
+		sort.Slice(locked.Retired, func(i, j int) bool { return locked.Retired[i].Number < locked.Retired[j].Number })
+	}
+	for msg, fields := range messages {
+		locked, ok := l.Messages[msg]
+		if !ok {
+			locked = &Message{}
+			l.Messages[msg] = locked
+		}
+		if locked.Fields == nil {
+			locked.Fields = map[string]int32{}
+		}
+		for name, number := range fields {
+			locked.Fields[name] = number
+		}
+	}
+	return nil
+}
+
+// check returns an error if the field name of msg may not use
+// number.
+func (m *Message) check(msg, name string, number int32) error {
+	if n, ok := m.Fields[name]; ok && n != number {
+		return fmt.Errorf("field %s.%s is locked to number %d, not %d", msg, name, n, number)
+	}
+	for other, n := range m.Fields {
+		if n == number && other != name {
+			return fmt.Errorf("field %s.%s uses number %d, which is locked to field %q", msg, name, number, other)
+		}
+	}
+	for _, f := range m.Retired {
+		if f.Number == number {
+			return fmt.Errorf("field %s.%s reuses number %d of retired field %q", msg, name, number, f.Name)
+		}
+		if f.Name == name {
+			return fmt.Errorf("field %s.%s reuses the name of a retired field", msg, name)
+		}
+	}
+	return nil
+}
+
+// Ranges groups the numbers of fields into inclusive ranges of
+// consecutive numbers. fields must be sorted by number.
+func Ranges(fields []Field) [][2]int32 {
+	ranges := [][2]int32{}
+	for _, f := range fields {
+		if n := len(ranges); n > 0 && ranges[n-1][1]+1 == f.Number {
+			ranges[n-1][1] = f.Number
+			continue
+		}
+		ranges = append(ranges, [2]int32{f.Number, f.Number})
+	}
+	return ranges
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,129 @@
+package lock
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const locked = `
+messages:
+  Book:
+    fields:
+      isbn: 1
+      price: 2
+    retired:
+      - {name: edition, number: 4}
+`
+
+func TestUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages map[string]map[string]int32
+		wantErr  string
+		want     map[string]*Message
+	}{
+		{
+			name:     "unchanged",
+			messages: map[string]map[string]int32{"Book": {"isbn": 1, "price": 2}},
+			want: map[string]*Message{
+				"Book": {Fields: map[string]int32{"isbn": 1, "price": 2}, Retired: []Field{{"edition", 4}}},
+			},
+		},
+		{
+			name:     "field added",
+			messages: map[string]map[string]int32{"Book": {"isbn": 1, "price": 2, "title": 5}},
+			want: map[string]*Message{
+				"Book": {Fields: map[string]int32{"isbn": 1, "price": 2, "title": 5}, Retired: []Field{{"edition", 4}}},
+			},
+		},
+		{
+			name:     "field removed",
+			messages: map[string]map[string]int32{"Book": {"isbn": 1}},
+			want: map[string]*Message{
+				"Book": {Fields: map[string]int32{"isbn": 1}, Retired: []Field{{"price", 2}, {"edition", 4}}},
+			},
+		},
+		{
+			name:     "message removed",
+			messages: map[string]map[string]int32{"Shelf": {"name": 1}},
+			want: map[string]*Message{
+				"Book":  {Fields: map[string]int32{}, Retired: []Field{{"isbn", 1}, {"price", 2}, {"edition", 4}}},
+				"Shelf": {Fields: map[string]int32{"name": 1}},
+			},
+		},
+		{
+			name:     "retired number reused",
+			messages: map[string]map[string]int32{"Book": {"isbn": 1, "price": 2, "title": 4}},
+			wantErr:  `field Book.title reuses number 4 of retired field "edition"`,
+		},
+		{
+			name:     "retired name reused",
+			messages: map[string]map[string]int32{"Book": {"isbn": 1, "price": 2, "edition": 5}},
+			wantErr:  "field Book.edition reuses the name of a retired field",
+		},
+		{
+			name:     "field renumbered",
+			messages: map[string]map[string]int32{"Book": {"isbn": 1, "price": 3}},
+			wantErr:  "field Book.price is locked to number 2, not 3",
+		},
+		{
+			name:     "field renamed",
+			messages: map[string]map[string]int32{"Book": {"isbn": 1, "cost": 2}},
+			wantErr:  `field Book.cost uses number 2, which is locked to field "price"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := Parse([]byte(locked))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			err = l.Update(tt.messages)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Update() error = %v, want %q", err, tt.wantErr)
+				}
+				if len(l.Messages["Book"].Fields) != 2 {
+					t.Errorf("Update() modified the lock on error: %+v", l.Messages["Book"])
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+			if !reflect.DeepEqual(l.Messages, tt.want) {
+				t.Errorf("Update() = %+v, want %+v", l.Messages, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	l, err := Parse([]byte(locked))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	b, err := l.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if !strings.HasPrefix(string(b), header) {
+		t.Errorf("Marshal() = %q, want the generated header", b)
+	}
+	got, err := Parse(b)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, l) {
+		t.Errorf("Parse(Marshal()) = %+v, want %+v", got, l)
+	}
+}
+
+func TestRanges(t *testing.T) {
+	got := Ranges([]Field{{"a", 2}, {"b", 3}, {"c", 4}, {"d", 7}, {"e", 9}, {"f", 10}})
+	want := [][2]int32{{2, 4}, {7, 7}, {9, 10}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Ranges() = %v, want %v", got, want)
+	}
+}
@@ -2,89 +2,513 @@ package cel2ansisql
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/operators"
 	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
 )
 
-// ConvertToSQL converts a CEL AST to ANSI SQL
-func ConvertToSQL(a *cel.Ast) (string, error) {
+// Placeholder returns the placeholder of the n-th bound
+// argument of a query, counting from 1.
+type Placeholder func(n int) string
+
+var (
+	// QuestionMark is the placeholder of SQLite and MySQL: ?.
+	QuestionMark Placeholder = func(int) string { return "?" }
+	// Dollar is the placeholder of PostgreSQL: $1, $2...
+	Dollar Placeholder = func(n int) string { return fmt.Sprintf("$%d", n) }
+	// AtP is the placeholder of SQL Server: @p1, @p2...
+	AtP Placeholder = func(n int) string { return fmt.Sprintf("@p%d", n) }
+)
+
+// Converter converts CEL ASTs to SQL conditions.
+type Converter struct {
+	// Dialect is the dialect of the SQL. Defaults to ANSI.
+	Dialect Dialect
+	// Placeholder, if set, binds the string and numeric
+	// constants of the expression as arguments rather than
+	// inlining them in the SQL.
+	Placeholder Placeholder
+	// Fields, if set, maps the fields expressions may reference
+	// to SQL. Other identifiers are rejected. If unset,
+	// identifiers are used as column names verbatim.
+	Fields Fields
+}
+
+// Convert converts a CEL AST to an SQL condition, and the
+// arguments bound to its placeholders, in order.
+func (c Converter) Convert(a *cel.Ast) (string, []any, error) {
 	checkedExpr, err := cel.AstToCheckedExpr(a)
 	if err != nil {
-		return "", err
+		return "", nil, err
+	}
+	if c.Dialect == nil {
+		c.Dialect = ANSI
+	}
+	conv := &conversion{Converter: c, types: checkedExpr.TypeMap, iterVars: map[string]string{}}
+	sql, err := conv.convertExpr(checkedExpr.Expr)
+	if err != nil {
+		return "", nil, err
+	}
+	return sql, conv.args, nil
+}
+
+// ConvertToSQL converts a CEL AST to ANSI SQL
+func ConvertToSQL(a *cel.Ast) (string, error) {
+	sql, _, err := Converter{}.Convert(a)
+	return sql, err
+}
+
+// ConvertToParameterizedSQL converts a CEL AST to ANSI SQL in
+// which constants are bound arguments, formatted by p, rather
+// than inlined. The arguments are returned in order.
+func ConvertToParameterizedSQL(a *cel.Ast, p Placeholder) (string, []any, error) {
+	return Converter{Placeholder: p}.Convert(a)
+}
+
+// conversion is the state of a single Convert.
+type conversion struct {
+	Converter
+	// types holds the type of each expression, by ID.
+	types map[int64]*exprpb.Type
+	args  []any
+	// iterVars maps the iteration variables of the enclosing
+	// comprehensions to the aliases of their elements.
+	iterVars map[string]string
+	// aliases counts the aliases of subqueries.
+	aliases int
+}
+
+// bind returns the placeholder of v, which is appended to the
+// arguments.
+func (c *conversion) bind(v any) string {
+	c.args = append(c.args, v)
+	return c.Placeholder(len(c.args))
+}
+
+// stringLiteral returns s as a bound argument or a literal.
+func (c *conversion) stringLiteral(s string) string {
+	if c.Placeholder != nil {
+		return c.bind(s)
 	}
-	expr := checkedExpr.Expr
-	return convertExpr(expr)
+	return c.Dialect.String(s)
 }
 
-func convertExpr(expr *exprpb.Expr) (string, error) {
+func (c *conversion) convertExpr(expr *exprpb.Expr) (string, error) {
 	switch expr.ExprKind.(type) {
 	case *exprpb.Expr_CallExpr:
-		return convertCall(expr.GetCallExpr())
+		return c.convertCall(expr)
 	case *exprpb.Expr_IdentExpr:
-		return handleIdentExpr(expr.GetIdentExpr())
+		return c.handleIdentExpr(expr)
 	case *exprpb.Expr_ConstExpr:
-		return handleConstExpr(expr.GetConstExpr())
+		return c.handleConstExpr(expr.GetConstExpr())
+	case *exprpb.Expr_SelectExpr:
+		return c.handleSelectExpr(expr.GetSelectExpr())
+	case *exprpb.Expr_ListExpr:
+		return c.handleListExpr(expr.GetListExpr())
+	case *exprpb.Expr_ComprehensionExpr:
+		return c.handleComprehensionExpr(expr.GetComprehensionExpr())
 	default:
 		return "", fmt.Errorf("unsupported expression type: %T", expr)
 	}
 }
 
-func convertCall(call *exprpb.Expr_Call) (string, error) {
+// operatorPrecedence returns the precedence of the operator
+// function, where higher binds more loosely, or 0 if function
+// is no operator. The precedences of CEL are doubled to fit
+// those of SQL in between.
+func operatorPrecedence(function string) int {
+	return 2 * operators.Precedence(function)
+}
+
+// comparisonPrecedence is the precedence of the conditions
+// generated for calls such as startsWith.
+var comparisonPrecedence = operatorPrecedence(operators.Equals)
+
+// predicatePrecedence is the precedence of the conditions
+// generated for has and all, IS NOT NULL and NOT EXISTS, and of
+// NOT, which bind more loosely than comparisons, but more
+// tightly than AND, in SQL.
+var predicatePrecedence = comparisonPrecedence + 1
+
+// precedence returns the precedence of the SQL generated for
+// expr, where higher binds more loosely, or 0 if no operator
+// can split it.
+func precedence(expr *exprpb.Expr) int {
+	if expr.GetSelectExpr().GetTestOnly() {
+		return predicatePrecedence
+	}
+	if macro, _, ok := comprehensionMacro(expr.GetComprehensionExpr()); ok && macro == allMacro {
+		return predicatePrecedence
+	}
+	call := expr.GetCallExpr()
+	if call == nil {
+		return 0
+	}
+	if call.Function == operators.LogicalNot {
+		// NOT binds as loosely as IS NOT NULL in SQL, unlike !
+		// in CEL.
+		return predicatePrecedence
+	}
+	if p := operatorPrecedence(call.Function); p > 0 {
+		return p
+	}
+	switch call.Function {
+	case "startsWith", "endsWith", "contains", "matches":
+		return comparisonPrecedence
+	}
+	return 0
+}
+
+// convertOperand converts operand of the operator function,
+// parenthesizing it if it would otherwise bind to another
+// operator.
+func (c *conversion) convertOperand(function string, operand *exprpb.Expr, right bool) (string, error) {
+	sql, err := c.convertExpr(operand)
+	if err != nil {
+		return "", err
+	}
+	p, parent := precedence(operand), operatorPrecedence(function)
+	if p > parent || (right && p > 0 && p == parent) {
+		return "(" + sql + ")", nil
+	}
+	return sql, nil
+}
+
+func (c *conversion) convertCall(expr *exprpb.Expr) (string, error) {
+	call := expr.GetCallExpr()
 	if call.Target != nil {
-		return convertCallWithTarget(call.Function, call.Target, call.Args)
+		return c.convertCallWithTarget(call.Function, call.Target, call.Args)
 	}
 	// Handle unary operators
 	if len(call.Args) == 1 {
-		return handleUnaryOp(call.Function, call.Args[0])
+		return c.handleUnaryOp(call.Function, call.Args[0])
 	}
 
 	if len(call.Args) == 2 {
-		return handleBinaryOp(call.Function, call.Args[0], call.Args[1])
+		return c.handleBinaryOp(expr, call.Function, call.Args[0], call.Args[1])
 	}
 	return "", fmt.Errorf("unsupported call expression: %v", call)
 }
 
-func convertCallWithTarget(function string, target *exprpb.Expr, args []*exprpb.Expr) (string, error) {
-	targetSQL, err := convertExpr(target)
+func (c *conversion) convertCallWithTarget(function string, target *exprpb.Expr, args []*exprpb.Expr) (string, error) {
+	targetSQL, err := c.convertOperand(operators.Equals, target, false)
 	if err != nil {
 		return "", err
 	}
+	if _, ok := timestampAccessors[function]; ok {
+		return c.handleTimestampAccessor(function, target, targetSQL, args)
+	}
 	if len(args) == 1 {
-		argSQL, err := convertExpr(args[0])
-		if err != nil {
-			return "", err
-		}
 		switch function {
 		case "startsWith":
-			return fmt.Sprintf("%s LIKE CONCAT(%s, '%%')", targetSQL, argSQL), nil
+			return c.match(targetSQL, args[0], false, true)
 		case "contains":
-			return fmt.Sprintf("%s LIKE CONCAT('%%', %s, '%%')", targetSQL, argSQL), nil
+			return c.match(targetSQL, args[0], true, true)
 		case "endsWith":
-			return fmt.Sprintf("%s LIKE CONCAT('%%', %s)", targetSQL, argSQL), nil
+			return c.match(targetSQL, args[0], true, false)
+		case "matches":
+			return c.regexp(targetSQL, args[0])
 		}
 	}
 	return "", fmt.Errorf("unsupported call expression with target: (%v, %v, %v)", function, target, args)
 }
 
-func handleIdentExpr(ident *exprpb.Expr_Ident) (string, error) {
-	return ident.Name, nil
+// match returns a condition that is true if targetSQL is the
+// string arg, preceded by any string if anyBefore and followed
+// by any string if anyAfter. The wildcards of arg match
+// themselves.
+func (c *conversion) match(targetSQL string, arg *exprpb.Expr, anyBefore, anyAfter bool) (string, error) {
+	d := c.Dialect
+	if s, ok := stringConstant(arg); ok {
+		pattern := d.EscapeWildcards(s)
+		if anyBefore {
+			pattern = d.Wildcard() + pattern
+		}
+		if anyAfter {
+			pattern += d.Wildcard()
+		}
+		return d.Match(targetSQL, c.stringLiteral(pattern)), nil
+	}
+	argSQL, err := c.convertExpr(arg)
+	if err != nil {
+		return "", err
+	}
+	parts := []string{d.EscapeWildcardsExpr(argSQL)}
+	if anyBefore {
+		parts = append([]string{d.String(d.Wildcard())}, parts...)
+	}
+	if anyAfter {
+		parts = append(parts, d.String(d.Wildcard()))
+	}
+	return d.Match(targetSQL, d.Concat(parts...)), nil
+}
+
+// caseInsensitiveFlag makes a regular expression ignore case.
+const caseInsensitiveFlag = "(?i)"
+
+// regexp returns a condition that is true if targetSQL matches
+// the regular expression arg. A constant expression starting
+// with caseInsensitiveFlag is matched ignoring case.
+func (c *conversion) regexp(targetSQL string, arg *exprpb.Expr) (string, error) {
+	if s, ok := stringConstant(arg); ok {
+		pattern, insensitive := strings.CutPrefix(s, caseInsensitiveFlag)
+		return c.Dialect.Regexp(targetSQL, c.stringLiteral(pattern), insensitive)
+	}
+	argSQL, err := c.convertExpr(arg)
+	if err != nil {
+		return "", err
+	}
+	return c.Dialect.Regexp(targetSQL, argSQL, false)
+}
+
+// stringConstant returns the value of expr, if it is a string
+// constant.
+func stringConstant(expr *exprpb.Expr) (string, bool) {
+	s, ok := expr.GetConstExpr().GetConstantKind().(*exprpb.Constant_StringValue)
+	if !ok {
+		return "", false
+	}
+	return s.StringValue, true
+}
+
+func (c *conversion) handleIdentExpr(expr *exprpb.Expr) (string, error) {
+	ident := expr.GetIdentExpr()
+	if alias, ok := c.iterVars[ident.Name]; ok {
+		return c.jsonValue(c.Dialect.JSONArrayElement(alias, nil), c.types[expr.Id]), nil
+	}
+	if c.Fields == nil {
+		if c.types[expr.Id].GetWellKnown() == exprpb.Type_TIMESTAMP {
+			return c.Dialect.Timestamp(ident.Name), nil
+		}
+		return ident.Name, nil
+	}
+	f, ok := c.Fields[ident.Name]
+	if !ok {
+		return "", fmt.Errorf("unknown field %q", ident.Name)
+	}
+	return f.sql(c.Dialect), nil
 }
 
-func handleUnaryOp(function string, argument *exprpb.Expr) (string, error) {
-	arg, err := convertExpr(argument)
+// handleSelectExpr converts the selection of a field, such as
+// book.price, or its test by has().
+func (c *conversion) handleSelectExpr(sel *exprpb.Expr_Select) (string, error) {
+	sql, err := c.selectSQL(sel.Operand, []string{sel.Field})
 	if err != nil {
 		return "", err
 	}
+	if sel.TestOnly {
+		return sql + " IS NOT NULL", nil
+	}
+	return sql, nil
+}
 
+// selectSQL returns the SQL of the value at path in operand.
+// Selections from fields and from the elements of
+// comprehensions are extracted from their JSON documents.
+// Without Fields, selections from identifiers are qualified
+// column names, such as book.price.
+func (c *conversion) selectSQL(operand *exprpb.Expr, path []string) (string, error) {
+	switch operand.ExprKind.(type) {
+	case *exprpb.Expr_SelectExpr:
+		sel := operand.GetSelectExpr()
+		if sel.TestOnly {
+			return "", fmt.Errorf("unsupported selection from has()")
+		}
+		return c.selectSQL(sel.Operand, append([]string{sel.Field}, path...))
+	case *exprpb.Expr_IdentExpr:
+		name := operand.GetIdentExpr().Name
+		if alias, ok := c.iterVars[name]; ok {
+			return c.Dialect.JSONArrayElement(alias, path), nil
+		}
+		if c.Fields == nil {
+			return strings.Join(append([]string{name}, path...), "."), nil
+		}
+		f, ok := c.Fields[name]
+		if !ok {
+			return "", fmt.Errorf("unknown field %q", name)
+		}
+		f.Path = append(append([]string{}, f.Path...), path...)
+		return f.sql(c.Dialect), nil
+	}
+	sql, err := c.convertExpr(operand)
+	if err != nil {
+		return "", err
+	}
+	return c.Dialect.JSONExtract(sql, path), nil
+}
+
+// handleListExpr converts a list to a parenthesized list of
+// values, as taken by IN.
+func (c *conversion) handleListExpr(list *exprpb.Expr_CreateList) (string, error) {
+	elements := make([]string, len(list.Elements))
+	for i, e := range list.Elements {
+		sql, err := c.convertExpr(e)
+		if err != nil {
+			return "", err
+		}
+		elements[i] = sql
+	}
+	return "(" + strings.Join(elements, ", ") + ")", nil
+}
+
+// The macros that comprehensions can be converted from.
+const (
+	existsMacro = "exists"
+	allMacro    = "all"
+)
+
+// comprehensionMacro returns the macro comp was expanded from,
+// exists or all, and its predicate.
+func comprehensionMacro(comp *exprpb.Expr_Comprehension) (string, *exprpb.Expr, bool) {
+	if comp == nil || comp.GetResult().GetIdentExpr().GetName() != comp.AccuVar {
+		return "", nil, false
+	}
+	init, ok := comp.GetAccuInit().GetConstExpr().GetConstantKind().(*exprpb.Constant_BoolValue)
+	if !ok {
+		return "", nil, false
+	}
+	step := comp.GetLoopStep().GetCallExpr()
+	if len(step.GetArgs()) != 2 || step.Args[0].GetIdentExpr().GetName() != comp.AccuVar {
+		return "", nil, false
+	}
+	switch {
+	case step.Function == operators.LogicalOr && !init.BoolValue:
+		return existsMacro, step.Args[1], true
+	case step.Function == operators.LogicalAnd && init.BoolValue:
+		return allMacro, step.Args[1], true
+	}
+	return "", nil, false
+}
+
+// handleComprehensionExpr converts the exists and all macros
+// over a list to subqueries over the elements of its JSON
+// array.
+func (c *conversion) handleComprehensionExpr(comp *exprpb.Expr_Comprehension) (string, error) {
+	macro, predicate, ok := comprehensionMacro(comp)
+	if !ok {
+		return "", fmt.Errorf("unsupported comprehension: only exists and all are supported")
+	}
+	if c.types[comp.IterRange.Id].GetListType() == nil {
+		return "", fmt.Errorf("unsupported comprehension: %s is only supported on lists", macro)
+	}
+	from, alias, err := c.jsonArrayElements(comp.IterRange)
+	if err != nil {
+		return "", err
+	}
+	outer, shadowed := c.iterVars[comp.IterVar]
+	c.iterVars[comp.IterVar] = alias
+	predicateSQL, err := c.convertExpr(predicate)
+	if shadowed {
+		c.iterVars[comp.IterVar] = outer
+	} else {
+		delete(c.iterVars, comp.IterVar)
+	}
+	if err != nil {
+		return "", err
+	}
+	if macro == allMacro {
+		return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s WHERE NOT (%s))", from, predicateSQL), nil
+	}
+	return fmt.Sprintf("EXISTS (SELECT 1 FROM %s WHERE %s)", from, predicateSQL), nil
+}
+
+// jsonValue converts the JSON scalar sql, an element of a JSON
+// array, to the SQL value of t if it is a number or a boolean.
+func (c *conversion) jsonValue(sql string, t *exprpb.Type) string {
+	if to, ok := jsonValuePrimitives[t.GetPrimitive()]; ok {
+		return c.Dialect.JSONValue(sql, to)
+	}
+	return sql
+}
+
+// jsonValuePrimitives are the names, as taken by
+// Dialect.JSONValue, of the primitive types of the JSON scalars
+// that are converted.
+var jsonValuePrimitives = map[exprpb.Type_PrimitiveType]string{
+	exprpb.Type_INT64:  "int",
+	exprpb.Type_UINT64: "uint",
+	exprpb.Type_DOUBLE: "double",
+	exprpb.Type_BOOL:   "bool",
+}
+
+// jsonArrayElements returns the table of the elements of the
+// JSON array list, and its alias.
+func (c *conversion) jsonArrayElements(list *exprpb.Expr) (string, string, error) {
+	sql, err := c.convertExpr(list)
+	if err != nil {
+		return "", "", err
+	}
+	c.aliases++
+	alias := fmt.Sprintf("e%d", c.aliases)
+	return c.Dialect.JSONArrayElements(sql, alias), alias, nil
+}
+
+// handleIn converts left in right, where right is a list
+// literal or a JSON array.
+func (c *conversion) handleIn(left, right *exprpb.Expr) (string, error) {
+	if list := right.GetListExpr(); list != nil {
+		if len(list.Elements) == 0 {
+			return c.Dialect.Bool(false), nil
+		}
+		leftSQL, err := c.convertOperand(operators.In, left, false)
+		if err != nil {
+			return "", err
+		}
+		rightSQL, err := c.handleListExpr(list)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s IN %s", leftSQL, rightSQL), nil
+	}
+	if c.types[right.Id].GetListType() == nil {
+		return "", fmt.Errorf("unsupported in: only lists are supported")
+	}
+	// the elements are converted first, as they come first in
+	// the SQL.
+	from, alias, err := c.jsonArrayElements(right)
+	if err != nil {
+		return "", err
+	}
+	leftSQL, err := c.convertOperand(operators.Equals, left, true)
+	if err != nil {
+		return "", err
+	}
+	element := c.jsonValue(c.Dialect.JSONArrayElement(alias, nil), c.types[right.Id].GetListType().GetElemType())
+	return fmt.Sprintf("EXISTS (SELECT 1 FROM %s WHERE %s = %s)", from, element, leftSQL), nil
+}
+
+func (c *conversion) handleUnaryOp(function string, argument *exprpb.Expr) (string, error) {
 	switch function {
-	case "!":
+	case operators.LogicalNot:
+		arg, err := c.convertExpr(argument)
+		if err != nil {
+			return "", err
+		}
 		return fmt.Sprintf("NOT (%s)", arg), nil
-	case "-":
+	case operators.Negate:
+		arg, err := c.convertOperand(function, argument, true)
+		if err != nil {
+			return "", err
+		}
 		return fmt.Sprintf("-%s", arg), nil
 	case "size":
-		return fmt.Sprintf("LENGTH(%s)", arg), nil
+		arg, err := c.convertExpr(argument)
+		if err != nil {
+			return "", err
+		}
+		if c.types[argument.Id].GetListType() != nil {
+			return c.Dialect.JSONArrayLength(arg), nil
+		}
+		return c.Dialect.Length(arg), nil
+	case "int", "uint", "double", "string":
+		return c.handleConversion(function, argument)
+	case "timestamp":
+		return c.handleTimestamp(argument)
+	case "duration":
+		return c.handleDuration(argument)
 	case "type":
 		return "", fmt.Errorf("type checking not supported in SQL conversion")
 	default:
@@ -92,66 +516,91 @@ func handleUnaryOp(function string, argument *exprpb.Expr) (string, error) {
 	}
 }
 
-func handleBinaryOp(function string, left *exprpb.Expr, right *exprpb.Expr) (string, error) {
-	leftSQL, err := convertExpr(left)
+func (c *conversion) handleBinaryOp(expr *exprpb.Expr, function string, left *exprpb.Expr, right *exprpb.Expr) (string, error) {
+	if err := c.checkDurations(expr, function, left, right); err != nil {
+		return "", err
+	}
+	if function == "matches" {
+		leftSQL, err := c.convertOperand(operators.Equals, left, false)
+		if err != nil {
+			return "", err
+		}
+		return c.regexp(leftSQL, right)
+	}
+	if function == operators.In {
+		return c.handleIn(left, right)
+	}
+	leftSQL, err := c.convertOperand(function, left, false)
 	if err != nil {
 		return "", err
 	}
-	rightSQL, err := convertExpr(right)
+	rightSQL, err := c.convertOperand(function, right, true)
 	if err != nil {
 		return "", err
 	}
 	switch function {
-	case "==":
+	case operators.Equals:
 		return fmt.Sprintf("%s = %s", leftSQL, rightSQL), nil
-	case "!=":
+	case operators.NotEquals:
 		return fmt.Sprintf("%s <> %s", leftSQL, rightSQL), nil
-	case "<":
+	case operators.Less:
 		return fmt.Sprintf("%s < %s", leftSQL, rightSQL), nil
-	case "<=":
+	case operators.LessEquals:
 		return fmt.Sprintf("%s <= %s", leftSQL, rightSQL), nil
-	case ">":
+	case operators.Greater:
 		return fmt.Sprintf("%s > %s", leftSQL, rightSQL), nil
-	case ">=":
+	case operators.GreaterEquals:
 		return fmt.Sprintf("%s >= %s", leftSQL, rightSQL), nil
-	case "&&":
+	case operators.LogicalAnd:
 		return fmt.Sprintf("%s AND %s", leftSQL, rightSQL), nil
-	case "||":
+	case operators.LogicalOr:
 		return fmt.Sprintf("%s OR %s", leftSQL, rightSQL), nil
-	case "+":
-		// TODO: handle string concatenation
+	case operators.Add:
+		if c.types[expr.Id].GetPrimitive() == exprpb.Type_STRING {
+			return c.Dialect.Concat(leftSQL, rightSQL), nil
+		}
 		return fmt.Sprintf("%s + %s", leftSQL, rightSQL), nil
-	case "-":
+	case operators.Subtract:
 		return fmt.Sprintf("%s - %s", leftSQL, rightSQL), nil
-	case "*":
+	case operators.Multiply:
 		return fmt.Sprintf("%s * %s", leftSQL, rightSQL), nil
-	case "/":
+	case operators.Divide:
 		return fmt.Sprintf("%s / %s", leftSQL, rightSQL), nil
-	case "%":
-		return fmt.Sprintf("%s %s %s", leftSQL, function, rightSQL), nil
-	// alphabetical
-	case "in":
-	case "has":
-		return fmt.Sprintf("%s IN %s", leftSQL, rightSQL), nil
-	case "matches":
-		return fmt.Sprintf("%s REGEXP %s", leftSQL, rightSQL), nil
+	case operators.Modulo:
+		return fmt.Sprintf("%s %% %s", leftSQL, rightSQL), nil
 	}
 	return "", fmt.Errorf("unsupported binary operator: %s", function)
 }
 
-func handleConstExpr(c *exprpb.Constant) (string, error) {
-	switch c.ConstantKind.(type) {
+func (c *conversion) handleConstExpr(constant *exprpb.Constant) (string, error) {
+	switch constant.ConstantKind.(type) {
 	case *exprpb.Constant_NullValue:
 		return "NULL", nil
 	case *exprpb.Constant_StringValue:
-		return fmt.Sprintf("'%s'", strings.ReplaceAll(c.GetStringValue(), "'", "''")), nil
+		return c.stringLiteral(constant.GetStringValue()), nil
 	case *exprpb.Constant_BoolValue:
-		return fmt.Sprintf("%t", c.GetBoolValue()), nil
+		return c.Dialect.Bool(constant.GetBoolValue()), nil
+	case *exprpb.Constant_BytesValue:
+		if c.Placeholder != nil {
+			return c.bind(constant.GetBytesValue()), nil
+		}
+		return c.Dialect.Bytes(constant.GetBytesValue()), nil
+	case *exprpb.Constant_Uint64Value:
+		if c.Placeholder != nil {
+			return c.bind(constant.GetUint64Value()), nil
+		}
+		return fmt.Sprintf("%d", constant.GetUint64Value()), nil
 	case *exprpb.Constant_Int64Value:
-		return fmt.Sprintf("%d", c.GetInt64Value()), nil
+		if c.Placeholder != nil {
+			return c.bind(constant.GetInt64Value()), nil
+		}
+		return fmt.Sprintf("%d", constant.GetInt64Value()), nil
 	case *exprpb.Constant_DoubleValue:
-		return fmt.Sprintf("%f", c.GetDoubleValue()), nil
+		if c.Placeholder != nil {
+			return c.bind(constant.GetDoubleValue()), nil
+		}
+		return strconv.FormatFloat(constant.GetDoubleValue(), 'g', -1, 64), nil
 	default:
-		return "", fmt.Errorf("unsupported constant type: %T", c.ConstantKind)
+		return "", fmt.Errorf("unsupported constant type: %T", constant.ConstantKind)
 	}
 }
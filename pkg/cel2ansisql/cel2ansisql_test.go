@@ -1,6 +1,7 @@
 package cel2ansisql
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/google/cel-go/cel"
@@ -10,6 +11,9 @@ func TestCELToSQL(t *testing.T) {
 	env, err := cel.NewEnv(
 		cel.Variable("path", cel.StringType),
 		cel.Variable("description", cel.StringType),
+		cel.Variable("book", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("published", cel.BoolType),
+		cel.Variable("price", cel.DoubleType),
 	)
 	if err != nil {
 		t.Fatalf("failed to create CEL environment: %v", err)
@@ -20,10 +24,63 @@ func TestCELToSQL(t *testing.T) {
 		input    string
 		expected string
 	}{
+		// the output of ConvertToSQL changed with dialects, e.g.
+		// from description LIKE CONCAT('tomorrow', '%'), as listed
+		// in the README; these cases pin each change.
 		{
 			name:     "simple expression",
 			input:    "description.startsWith('tomorrow')",
-			expected: "description LIKE CONCAT('tomorrow', '%')",
+			expected: `description LIKE 'tomorrow%' ESCAPE '\'`,
+		},
+		{
+			name:     "doubles keep their precision",
+			input:    "price == 0.0000001 || price > 4.5",
+			expected: "price = 1e-07 OR price > 4.5",
+		},
+		{
+			name:     "wildcards match themselves",
+			input:    "description.startsWith('a_') || description.contains('a')",
+			expected: `description LIKE 'a\_%' ESCAPE '\' OR description LIKE '%a%' ESCAPE '\'`,
+		},
+		{
+			name:     "standard literals and functions",
+			input:    "published == true && size(path) > 1 || path + 'a' == description || matches(path, 'a')",
+			expected: "published = TRUE AND CHAR_LENGTH(path) > 1 OR (path || 'a') = description OR path LIKE_REGEX 'a'",
+		},
+		{
+			name:     "operands are parenthesized",
+			input:    "(published || path == 'a') && description == 'b'",
+			expected: "(published OR path = 'a') AND description = 'b'",
+		},
+		{
+			name:     "in a list",
+			input:    "path in ['a', 'b'] && !(size(description) in [1])",
+			expected: "path IN ('a', 'b') AND NOT (CHAR_LENGTH(description) IN (1))",
+		},
+		{
+			name:     "in an empty list",
+			input:    "path in []",
+			expected: "FALSE",
+		},
+		{
+			name:     "selection",
+			input:    "book.price > 10 && book.author.name == 'x'",
+			expected: "book.price > 10 AND book.author.name = 'x'",
+		},
+		{
+			name:     "has",
+			input:    "has(book.price) == true || !has(book.isbn)",
+			expected: "(book.price IS NOT NULL) = TRUE OR NOT (book.isbn IS NOT NULL)",
+		},
+		{
+			name:     "not of a comparison operand",
+			input:    "!published in [true, false] || !published < published",
+			expected: "(NOT (published)) IN (TRUE, FALSE) OR (NOT (published)) < published",
+		},
+		{
+			name:     "not of a conjunction",
+			input:    "!published && !(published || path == 'a')",
+			expected: "NOT (published) AND NOT (published OR path = 'a')",
 		},
 	}
 
@@ -43,3 +100,71 @@ func TestCELToSQL(t *testing.T) {
 		})
 	}
 }
+
+func TestCELToParameterizedSQL(t *testing.T) {
+	env, err := cel.NewEnv(
+		cel.Variable("path", cel.StringType),
+		cel.Variable("description", cel.StringType),
+		cel.Variable("edition", cel.IntType),
+		cel.Variable("price", cel.DoubleType),
+	)
+	if err != nil {
+		t.Fatalf("failed to create CEL environment: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		input        string
+		placeholder  Placeholder
+		expected     string
+		expectedArgs []any
+	}{
+		{
+			name:         "question mark",
+			input:        "description.startsWith('tomorrow')",
+			placeholder:  QuestionMark,
+			expected:     `description LIKE ? ESCAPE '\'`,
+			expectedArgs: []any{"tomorrow%"},
+		},
+		{
+			name:         "dollar",
+			input:        "path == 'publishers/1' && edition > 2",
+			placeholder:  Dollar,
+			expected:     "path = $1 AND edition > $2",
+			expectedArgs: []any{"publishers/1", int64(2)},
+		},
+		{
+			name:         "at p",
+			input:        "price <= 9.5 || description != \"it's\"",
+			placeholder:  AtP,
+			expected:     "price <= @p1 OR description <> @p2",
+			expectedArgs: []any{9.5, "it's"},
+		},
+		{
+			name:         "booleans are inlined",
+			input:        "!(description.endsWith('.')) == true",
+			placeholder:  QuestionMark,
+			expected:     `(NOT (description LIKE ? ESCAPE '\')) = TRUE`,
+			expectedArgs: []any{"%."},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ast, iss := env.Compile(tt.input)
+			if iss.Err() != nil {
+				t.Fatalf("compile() = %v, want %v", iss.Err(), tt.expected)
+			}
+			got, args, err := ConvertToParameterizedSQL(ast, tt.placeholder)
+			if err != nil {
+				t.Fatalf("ConvertToParameterizedSQL() = %v, want %v", err, tt.expected)
+			}
+			if got != tt.expected {
+				t.Errorf("ConvertToParameterizedSQL() = %v, want %v", got, tt.expected)
+			}
+			if !reflect.DeepEqual(args, tt.expectedArgs) {
+				t.Errorf("ConvertToParameterizedSQL() args = %#v, want %#v", args, tt.expectedArgs)
+			}
+		})
+	}
+}
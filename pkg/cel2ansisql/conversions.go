@@ -0,0 +1,146 @@
+package cel2ansisql
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/cel-go/common/operators"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+// sqlTimestampLayout is the layout of timestamp constants, which
+// every dialect parses.
+const sqlTimestampLayout = "2006-01-02 15:04:05.999999-07:00"
+
+// handleConversion converts the CEL conversion function to,
+// int, uint, double or string, of argument.
+func (c *conversion) handleConversion(to string, argument *exprpb.Expr) (string, error) {
+	arg, err := c.convertExpr(argument)
+	if err != nil {
+		return "", err
+	}
+	from := c.types[argument.Id]
+	switch {
+	case from.GetPrimitive() == primitives[to]:
+		return arg, nil
+	case from.GetPrimitive() == exprpb.Type_DOUBLE && (to == "int" || to == "uint"):
+		return c.Dialect.Cast(c.Dialect.Trunc(arg), to), nil
+	case from.GetWellKnown() == exprpb.Type_TIMESTAMP && to == "int":
+		return c.Dialect.Cast(c.Dialect.Trunc(c.Dialect.Epoch(arg)), to), nil
+	}
+	if from.GetDyn() != nil {
+		return c.Dialect.Cast(arg, to), nil
+	}
+	switch from.GetPrimitive() {
+	case exprpb.Type_INT64, exprpb.Type_UINT64, exprpb.Type_DOUBLE, exprpb.Type_STRING:
+		return c.Dialect.Cast(arg, to), nil
+	}
+	return "", fmt.Errorf("unsupported conversion to %s of %v", to, from)
+}
+
+// primitives are the types of the CEL conversion functions.
+var primitives = map[string]exprpb.Type_PrimitiveType{
+	"int":    exprpb.Type_INT64,
+	"uint":   exprpb.Type_UINT64,
+	"double": exprpb.Type_DOUBLE,
+	"string": exprpb.Type_STRING,
+}
+
+// handleTimestamp converts timestamp() of a string. Constants
+// are validated, and normalized to UTC.
+func (c *conversion) handleTimestamp(argument *exprpb.Expr) (string, error) {
+	if s, ok := stringConstant(argument); ok {
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return "", fmt.Errorf("invalid timestamp %q: %w", s, err)
+		}
+		return c.Dialect.Timestamp(c.stringLiteral(t.UTC().Format(sqlTimestampLayout))), nil
+	}
+	if c.types[argument.Id].GetPrimitive() != exprpb.Type_STRING {
+		return "", fmt.Errorf("unsupported timestamp(): only strings are supported")
+	}
+	arg, err := c.convertExpr(argument)
+	if err != nil {
+		return "", err
+	}
+	return c.Dialect.Timestamp(arg), nil
+}
+
+// handleDuration converts duration() of a string constant, such
+// as "1h30m", to its number of seconds.
+func (c *conversion) handleDuration(argument *exprpb.Expr) (string, error) {
+	s, ok := stringConstant(argument)
+	if !ok {
+		return "", fmt.Errorf("unsupported duration(): only constants are supported")
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid duration %q: %w", s, err)
+	}
+	if c.Placeholder != nil {
+		return c.Dialect.Duration(c.bind(d.Seconds())), nil
+	}
+	return c.Dialect.Duration(strconv.FormatFloat(d.Seconds(), 'f', -1, 64)), nil
+}
+
+// checkDurations returns an error if the dialect has no
+// DurationValues, and the operator function of expr takes or
+// returns durations other than by adding them to or
+// subtracting them from timestamps.
+func (c *conversion) checkDurations(expr *exprpb.Expr, function string, args ...*exprpb.Expr) error {
+	if c.Dialect.DurationValues() {
+		return nil
+	}
+	if c.types[expr.Id].GetWellKnown() == exprpb.Type_TIMESTAMP && (function == operators.Add || function == operators.Subtract) {
+		return nil
+	}
+	isDuration := func(e *exprpb.Expr) bool {
+		return c.types[e.Id].GetWellKnown() == exprpb.Type_DURATION
+	}
+	if isDuration(expr) || slices.ContainsFunc(args, isDuration) {
+		return fmt.Errorf("unsupported duration: only adding durations to and subtracting them from timestamps is supported")
+	}
+	return nil
+}
+
+// timestampAccessor is the part of a timestamp that a CEL
+// accessor returns, numbered from offset more than in SQL.
+type timestampAccessor struct {
+	part   TimestampPart
+	offset int
+}
+
+var timestampAccessors = map[string]timestampAccessor{
+	"getFullYear":     {Year, 0},
+	"getMonth":        {Month, -1},
+	"getDate":         {Day, 0},
+	"getDayOfMonth":   {Day, -1},
+	"getDayOfWeek":    {DayOfWeek, 0},
+	"getDayOfYear":    {DayOfYear, -1},
+	"getHours":        {Hour, 0},
+	"getMinutes":      {Minute, 0},
+	"getSeconds":      {Second, 0},
+	"getMilliseconds": {Millisecond, 0},
+}
+
+// handleTimestampAccessor converts the accessor function of the
+// timestamp target, in UTC.
+func (c *conversion) handleTimestampAccessor(function string, target *exprpb.Expr, targetSQL string, args []*exprpb.Expr) (string, error) {
+	if c.types[target.Id].GetWellKnown() != exprpb.Type_TIMESTAMP {
+		return "", fmt.Errorf("unsupported %s(): only timestamps are supported", function)
+	}
+	if len(args) > 0 {
+		return "", fmt.Errorf("unsupported %s(): time zones are not supported", function)
+	}
+	a := timestampAccessors[function]
+	sql, err := c.Dialect.TimestampPart(targetSQL, a.part)
+	if err != nil {
+		return "", err
+	}
+	if a.offset != 0 {
+		return fmt.Sprintf("(%s - %d)", sql, -a.offset), nil
+	}
+	return sql, nil
+}
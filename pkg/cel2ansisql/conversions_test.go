@@ -0,0 +1,206 @@
+package cel2ansisql
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/cel-go/cel"
+)
+
+func TestConversions(t *testing.T) {
+	env, err := cel.NewEnv(
+		cel.Variable("edition", cel.IntType),
+		cel.Variable("price", cel.DoubleType),
+		cel.Variable("isbn", cel.StringType),
+		cel.Variable("code", cel.UintType),
+		cel.Variable("data", cel.BytesType),
+		cel.Variable("create_time", cel.TimestampType),
+	)
+	if err != nil {
+		t.Fatalf("failed to create CEL environment: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected map[Dialect]string
+		wantErr  string
+		// dialect is the dialect of wantErr, ANSI if unset.
+		dialect Dialect
+	}{
+		{
+			name:  "int",
+			input: "int(price) >= 2 && int(isbn) == edition",
+			expected: map[Dialect]string{
+				ANSI:       `CAST(TRUNC(price) AS BIGINT) >= 2 AND CAST(isbn AS BIGINT) = edition`,
+				SQLite:     `CAST(price AS INTEGER) >= 2 AND CAST(isbn AS INTEGER) = edition`,
+				PostgreSQL: `CAST(TRUNC(price) AS BIGINT) >= 2 AND CAST(isbn AS BIGINT) = edition`,
+				MySQL:      `CAST(TRUNCATE(price, 0) AS SIGNED) >= 2 AND CAST(isbn AS SIGNED) = edition`,
+			},
+		},
+		{
+			name:  "double, string and identities",
+			input: "double(edition) < price && string(edition) == string(isbn)",
+			expected: map[Dialect]string{
+				ANSI:       `CAST(edition AS DOUBLE PRECISION) < price AND CAST(edition AS VARCHAR) = isbn`,
+				SQLite:     `CAST(edition AS REAL) < price AND CAST(edition AS TEXT) = isbn`,
+				PostgreSQL: `CAST(edition AS DOUBLE PRECISION) < price AND CAST(edition AS TEXT) = isbn`,
+				MySQL:      `CAST(edition AS DOUBLE) < price AND CAST(edition AS CHAR) = isbn`,
+			},
+		},
+		{
+			name:  "uint and bytes constants",
+			input: "code == 7u && data == b'\\xde\\xad'",
+			expected: map[Dialect]string{
+				ANSI:       `code = 7 AND data = X'DEAD'`,
+				SQLite:     `code = 7 AND data = X'DEAD'`,
+				PostgreSQL: `code = 7 AND data = '\xdead'::BYTEA`,
+				MySQL:      `code = 7 AND data = X'DEAD'`,
+			},
+		},
+		{
+			name:  "timestamps",
+			input: `create_time > timestamp("2024-01-01T01:00:00+01:00")`,
+			expected: map[Dialect]string{
+				ANSI:       `CAST(create_time AS TIMESTAMP WITH TIME ZONE) > CAST('2024-01-01 00:00:00+00:00' AS TIMESTAMP WITH TIME ZONE)`,
+				SQLite:     `UNIXEPOCH(create_time, 'subsec') > UNIXEPOCH('2024-01-01 00:00:00+00:00', 'subsec')`,
+				PostgreSQL: `CAST(create_time AS TIMESTAMP WITH TIME ZONE) > CAST('2024-01-01 00:00:00+00:00' AS TIMESTAMP WITH TIME ZONE)`,
+				MySQL:      `CAST(create_time AS DATETIME(6)) > CAST('2024-01-01 00:00:00+00:00' AS DATETIME(6))`,
+			},
+		},
+		{
+			name:  "durations",
+			input: `create_time + duration("1h30m") < timestamp(isbn)`,
+			expected: map[Dialect]string{
+				ANSI:   `CAST(create_time AS TIMESTAMP WITH TIME ZONE) + (5400 * INTERVAL '1' SECOND) < CAST(isbn AS TIMESTAMP WITH TIME ZONE)`,
+				SQLite: `UNIXEPOCH(create_time, 'subsec') + 5400 < UNIXEPOCH(isbn, 'subsec')`,
+				MySQL:  `CAST(create_time AS DATETIME(6)) + INTERVAL 5400 SECOND < CAST(isbn AS DATETIME(6))`,
+			},
+		},
+		{
+			name:  "accessors",
+			input: `create_time.getFullYear() == 2024 && create_time.getMonth() == 0 && create_time.getDayOfWeek() == 1`,
+			expected: map[Dialect]string{
+				SQLite: `CAST(STRFTIME('%Y', UNIXEPOCH(create_time, 'subsec'), 'unixepoch') AS INTEGER) = 2024 AND ` +
+					`(CAST(STRFTIME('%m', UNIXEPOCH(create_time, 'subsec'), 'unixepoch') AS INTEGER) - 1) = 0 AND ` +
+					`CAST(STRFTIME('%w', UNIXEPOCH(create_time, 'subsec'), 'unixepoch') AS INTEGER) = 1`,
+				PostgreSQL: `EXTRACT(YEAR FROM CAST(create_time AS TIMESTAMP WITH TIME ZONE) AT TIME ZONE 'UTC') = 2024 AND ` +
+					`(EXTRACT(MONTH FROM CAST(create_time AS TIMESTAMP WITH TIME ZONE) AT TIME ZONE 'UTC') - 1) = 0 AND ` +
+					`EXTRACT(DOW FROM CAST(create_time AS TIMESTAMP WITH TIME ZONE) AT TIME ZONE 'UTC') = 1`,
+				MySQL: `YEAR(CAST(create_time AS DATETIME(6))) = 2024 AND ` +
+					`(MONTH(CAST(create_time AS DATETIME(6))) - 1) = 0 AND ` +
+					`(DAYOFWEEK(CAST(create_time AS DATETIME(6))) - 1) = 1`,
+			},
+		},
+		{
+			name:  "int of a timestamp",
+			input: `int(create_time) > 0`,
+			expected: map[Dialect]string{
+				SQLite:     `CAST(UNIXEPOCH(create_time, 'subsec') AS INTEGER) > 0`,
+				PostgreSQL: `CAST(TRUNC(EXTRACT(EPOCH FROM CAST(create_time AS TIMESTAMP WITH TIME ZONE))) AS BIGINT) > 0`,
+			},
+		},
+		{
+			name:    "invalid timestamp",
+			input:   `create_time > timestamp("yesterday")`,
+			wantErr: `invalid timestamp "yesterday"`,
+		},
+		{
+			name:    "time zones",
+			input:   `create_time.getHours("Europe/Paris") == 1`,
+			wantErr: "time zones are not supported",
+		},
+		{
+			name:    "day of week in ANSI",
+			input:   `create_time.getDayOfWeek() == 1`,
+			wantErr: "unsupported timestamp part: DOW",
+		},
+		{
+			name:  "durations subtracted from and added to timestamps in MySQL",
+			input: `create_time - duration("1s") < timestamp(isbn) && duration("1m") + create_time > create_time`,
+			expected: map[Dialect]string{
+				MySQL: `CAST(create_time AS DATETIME(6)) - INTERVAL 1 SECOND < CAST(isbn AS DATETIME(6)) AND INTERVAL 60 SECOND + CAST(create_time AS DATETIME(6)) > CAST(create_time AS DATETIME(6))`,
+			},
+		},
+		{
+			name:    "compared durations in MySQL",
+			input:   `duration("1h") > duration("30m")`,
+			dialect: MySQL,
+			wantErr: "unsupported duration",
+		},
+		{
+			name:    "subtracted timestamps in MySQL",
+			input:   `create_time - create_time > duration("1s")`,
+			dialect: MySQL,
+			wantErr: "unsupported duration",
+		},
+		{
+			name:    "added durations in MySQL",
+			input:   `create_time + (duration("1s") + duration("1s")) > create_time`,
+			dialect: MySQL,
+			wantErr: "unsupported duration",
+		},
+		{
+			name:  "compared durations",
+			input: `create_time - create_time > duration("1s")`,
+			expected: map[Dialect]string{
+				SQLite:     `UNIXEPOCH(create_time, 'subsec') - UNIXEPOCH(create_time, 'subsec') > 1`,
+				PostgreSQL: `CAST(create_time AS TIMESTAMP WITH TIME ZONE) - CAST(create_time AS TIMESTAMP WITH TIME ZONE) > (1 * INTERVAL '1' SECOND)`,
+			},
+		},
+		{
+			name:    "string of a timestamp",
+			input:   `string(create_time) == ''`,
+			wantErr: "unsupported conversion to string",
+		},
+	}
+
+	for _, tt := range tests {
+		ast, iss := env.Compile(tt.input)
+		if iss.Err() != nil {
+			t.Fatalf("compile(%q) = %v", tt.input, iss.Err())
+		}
+		if tt.wantErr != "" {
+			_, _, err := Converter{Dialect: tt.dialect}.Convert(ast)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Convert(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+			continue
+		}
+		for dialect, expected := range tt.expected {
+			t.Run(tt.name+"/"+dialectName(dialect), func(t *testing.T) {
+				got, _, err := Converter{Dialect: dialect}.Convert(ast)
+				if err != nil {
+					t.Fatalf("Convert() = %v, want %v", err, expected)
+				}
+				if got != expected {
+					t.Errorf("Convert() = %v, want %v", got, expected)
+				}
+			})
+		}
+	}
+}
+
+func TestParameterizedConversions(t *testing.T) {
+	env, err := cel.NewEnv(cel.Variable("create_time", cel.TimestampType))
+	if err != nil {
+		t.Fatalf("failed to create CEL environment: %v", err)
+	}
+	ast, iss := env.Compile(`create_time - duration("-1.5s") >= timestamp("2024-06-01T12:00:00.25Z") && 3u == 3u`)
+	if iss.Err() != nil {
+		t.Fatalf("compile() = %v", iss.Err())
+	}
+	got, args, err := Converter{Dialect: PostgreSQL, Placeholder: Dollar}.Convert(ast)
+	if err != nil {
+		t.Fatalf("Convert() = %v", err)
+	}
+	expected := `CAST(create_time AS TIMESTAMP WITH TIME ZONE) - ($1 * INTERVAL '1' SECOND) >= CAST($2 AS TIMESTAMP WITH TIME ZONE) AND $3 = $4`
+	if got != expected {
+		t.Errorf("Convert() = %v, want %v", got, expected)
+	}
+	expectedArgs := []any{-1.5, "2024-06-01 12:00:00.25+00:00", uint64(3), uint64(3)}
+	if !reflect.DeepEqual(args, expectedArgs) {
+		t.Errorf("Convert() args = %#v, want %#v", args, expectedArgs)
+	}
+}
@@ -0,0 +1,574 @@
+package cel2ansisql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Dialect generates the SQL constructs that differ between
+// databases.
+type Dialect interface {
+	// QuoteIdentifier quotes name, such as a column name, so
+	// that it is never read as a keyword.
+	QuoteIdentifier(name string) string
+	// String returns the literal of s.
+	String(s string) string
+	// Bool returns the literal of b.
+	Bool(b bool) string
+	// Concat concatenates the string expressions exprs.
+	Concat(exprs ...string) string
+	// Length returns the number of characters of the string
+	// expression expr.
+	Length(expr string) string
+	// Wildcard is the wildcard of the patterns of Match,
+	// matching any string.
+	Wildcard() string
+	// EscapeWildcards escapes s so that it matches itself in
+	// the patterns of Match.
+	EscapeWildcards(s string) string
+	// EscapeWildcardsExpr is EscapeWildcards for the string
+	// expression expr.
+	EscapeWildcardsExpr(expr string) string
+	// Match returns a case-sensitive condition that is true
+	// if the string expression expr matches pattern.
+	Match(expr, pattern string) string
+	// Regexp returns a condition that is true if the string
+	// expression expr matches the regular expression pattern,
+	// ignoring case if insensitive.
+	Regexp(expr, pattern string, insensitive bool) (string, error)
+	// JSONExtract returns the value at path in the JSON
+	// document expr, such as a column.
+	JSONExtract(expr string, path []string) string
+	// JSONArrayElements returns a table of the elements of the
+	// JSON array expr, named alias, for the FROM clause of a
+	// subquery.
+	JSONArrayElements(expr, alias string) string
+	// JSONArrayElement returns the value at path in the element
+	// of the table alias returned by JSONArrayElements, or the
+	// element itself if path is empty.
+	JSONArrayElement(alias string, path []string) string
+	// JSONArrayLength returns the number of elements of the
+	// JSON array expr.
+	JSONArrayLength(expr string) string
+	// JSONValue converts expr, a JSON scalar returned by
+	// JSONExtract or JSONArrayElement, to the CEL type named
+	// to: int, uint, double or bool.
+	JSONValue(expr string, to string) string
+	// Bytes returns the literal of b.
+	Bytes(b []byte) string
+	// Cast converts expr to the CEL type named to: int, uint,
+	// double or string.
+	Cast(expr string, to string) string
+	// Trunc truncates the number expr toward zero.
+	Trunc(expr string) string
+	// Timestamp converts the string expression expr, an RFC 3339
+	// or SQL timestamp, to the timestamps that the dialect
+	// compares and adds durations to.
+	Timestamp(expr string) string
+	// Duration returns the duration of the number of seconds
+	// expr, which can be added to the timestamps of Timestamp.
+	Duration(seconds string) string
+	// DurationValues reports whether durations can be compared
+	// and computed with, and timestamps subtracted from each
+	// other. If not, durations can only be added to and
+	// subtracted from timestamps.
+	DurationValues() bool
+	// Epoch returns the number of seconds since the Unix epoch
+	// of the timestamp expr.
+	Epoch(timestamp string) string
+	// TimestampPart returns part of the timestamp expr in UTC,
+	// as a number.
+	TimestampPart(timestamp string, part TimestampPart) (string, error)
+}
+
+// TimestampPart is a part of a timestamp, numbered as in SQL.
+type TimestampPart string
+
+const (
+	Year TimestampPart = "YEAR"
+	// Month is the month of the year, from 1.
+	Month TimestampPart = "MONTH"
+	// Day is the day of the month, from 1.
+	Day TimestampPart = "DAY"
+	// DayOfWeek is the day of the week, from 0 for Sunday.
+	DayOfWeek TimestampPart = "DOW"
+	// DayOfYear is the day of the year, from 1.
+	DayOfYear   TimestampPart = "DOY"
+	Hour        TimestampPart = "HOUR"
+	Minute      TimestampPart = "MINUTE"
+	Second      TimestampPart = "SECOND"
+	Millisecond TimestampPart = "MILLISECOND"
+)
+
+var (
+	// ANSI generates standard SQL.
+	ANSI Dialect = ansi{}
+	// SQLite generates SQL for SQLite. Regular expressions
+	// require a regexp function to be registered with the
+	// connection.
+	SQLite Dialect = sqlite{}
+	// PostgreSQL generates SQL for PostgreSQL.
+	PostgreSQL Dialect = postgreSQL{}
+	// MySQL generates SQL for MySQL 8.
+	MySQL Dialect = mySQL{}
+)
+
+// likeEscaper escapes the wildcards of LIKE patterns, whose
+// escape character is a backslash.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// ansi generates standard SQL, matching patterns with LIKE.
+type ansi struct{}
+
+func (ansi) QuoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func (ansi) String(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func (ansi) Bool(b bool) string {
+	if b {
+		return "TRUE"
+	}
+	return "FALSE"
+}
+
+func (ansi) Concat(exprs ...string) string {
+	return "(" + strings.Join(exprs, " || ") + ")"
+}
+
+func (ansi) Length(expr string) string {
+	return fmt.Sprintf("CHAR_LENGTH(%s)", expr)
+}
+
+func (ansi) Wildcard() string { return "%" }
+
+func (ansi) EscapeWildcards(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+func (ansi) EscapeWildcardsExpr(expr string) string {
+	return fmt.Sprintf(`REPLACE(REPLACE(REPLACE(%s, '\', '\\'), '%%', '\%%'), '_', '\_')`, expr)
+}
+
+func (ansi) Match(expr, pattern string) string {
+	return fmt.Sprintf(`%s LIKE %s ESCAPE '\'`, expr, pattern)
+}
+
+func (ansi) Regexp(expr, pattern string, insensitive bool) (string, error) {
+	if insensitive {
+		return fmt.Sprintf("%s LIKE_REGEX %s FLAG 'i'", expr, pattern), nil
+	}
+	return fmt.Sprintf("%s LIKE_REGEX %s", expr, pattern), nil
+}
+
+func (d ansi) JSONExtract(expr string, path []string) string {
+	return fmt.Sprintf("JSON_VALUE(%s, %s)", expr, d.String(jsonPath(path)))
+}
+
+func (d ansi) JSONArrayElements(expr, alias string) string {
+	return fmt.Sprintf("JSON_TABLE(%s, '$[*]' COLUMNS (value JSON PATH '$')) AS %s", expr, alias)
+}
+
+func (d ansi) JSONArrayElement(alias string, path []string) string {
+	return d.JSONExtract(alias+".value", path)
+}
+
+func (ansi) JSONArrayLength(expr string) string {
+	return fmt.Sprintf("JSON_VALUE(%s, '$.size()' RETURNING BIGINT)", expr)
+}
+
+// JSONValue casts the text that JSON_VALUE returns.
+func (ansi) JSONValue(expr string, to string) string {
+	if to == "bool" {
+		return fmt.Sprintf("CAST(%s AS BOOLEAN)", expr)
+	}
+	return fmt.Sprintf("CAST(%s AS %s)", expr, ansiTypes[to])
+}
+
+func (ansi) Bytes(b []byte) string {
+	return fmt.Sprintf("X'%X'", b)
+}
+
+// ansiTypes are the SQL types of the CEL types of Cast.
+var ansiTypes = map[string]string{
+	"int":    "BIGINT",
+	"uint":   "BIGINT",
+	"double": "DOUBLE PRECISION",
+	"string": "VARCHAR",
+}
+
+func (ansi) Cast(expr string, to string) string {
+	return fmt.Sprintf("CAST(%s AS %s)", expr, ansiTypes[to])
+}
+
+func (ansi) Trunc(expr string) string {
+	return fmt.Sprintf("TRUNC(%s)", expr)
+}
+
+func (ansi) Timestamp(expr string) string {
+	return fmt.Sprintf("CAST(%s AS TIMESTAMP WITH TIME ZONE)", expr)
+}
+
+func (ansi) Duration(seconds string) string {
+	return fmt.Sprintf("(%s * INTERVAL '1' SECOND)", seconds)
+}
+
+func (ansi) DurationValues() bool {
+	return true
+}
+
+func (ansi) Epoch(timestamp string) string {
+	return fmt.Sprintf("EXTRACT(EPOCH FROM %s)", timestamp)
+}
+
+// TimestampPart extracts the parts of standard SQL, which lacks
+// the day of the week and of the year.
+func (ansi) TimestampPart(timestamp string, part TimestampPart) (string, error) {
+	utc := timestamp + " AT TIME ZONE 'UTC'"
+	switch part {
+	case Second:
+		return fmt.Sprintf("FLOOR(EXTRACT(SECOND FROM %s))", utc), nil
+	case Millisecond:
+		return fmt.Sprintf("MOD(FLOOR(EXTRACT(SECOND FROM %s) * 1000), 1000)", utc), nil
+	case DayOfWeek, DayOfYear:
+		return "", fmt.Errorf("unsupported timestamp part: %s", part)
+	}
+	return fmt.Sprintf("EXTRACT(%s FROM %s)", part, utc), nil
+}
+
+// jsonKey matches the keys of JSON objects that need no quoting
+// in paths.
+var jsonKey = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// jsonPath returns path as an SQL/JSON path expression, such
+// as $.author.given_name.
+func jsonPath(path []string) string {
+	var sb strings.Builder
+	sb.WriteString("$")
+	for _, key := range path {
+		sb.WriteString(".")
+		if jsonKey.MatchString(key) {
+			sb.WriteString(key)
+		} else {
+			sb.WriteString(strconv.Quote(key))
+		}
+	}
+	return sb.String()
+}
+
+// sqlite generates SQL for SQLite. Its LIKE ignores case, so
+// patterns are matched with GLOB instead.
+type sqlite struct {
+	ansi
+}
+
+func (sqlite) Bool(b bool) string {
+	// TRUE and FALSE are only keywords since SQLite 3.23.
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+func (sqlite) Length(expr string) string {
+	return fmt.Sprintf("LENGTH(%s)", expr)
+}
+
+func (sqlite) Wildcard() string { return "*" }
+
+// globEscaper escapes the wildcards of GLOB patterns, which
+// have no escape character, as character classes.
+var globEscaper = strings.NewReplacer(`[`, `[[]`, `*`, `[*]`, `?`, `[?]`)
+
+func (sqlite) EscapeWildcards(s string) string {
+	return globEscaper.Replace(s)
+}
+
+func (sqlite) EscapeWildcardsExpr(expr string) string {
+	return fmt.Sprintf(`REPLACE(REPLACE(REPLACE(%s, '[', '[[]'), '*', '[*]'), '?', '[?]')`, expr)
+}
+
+func (sqlite) Match(expr, pattern string) string {
+	return fmt.Sprintf("%s GLOB %s", expr, pattern)
+}
+
+func (d sqlite) Regexp(expr, pattern string, insensitive bool) (string, error) {
+	if insensitive {
+		pattern = d.Concat("'(?i)'", pattern)
+	}
+	return fmt.Sprintf("%s REGEXP %s", expr, pattern), nil
+}
+
+func (d sqlite) JSONExtract(expr string, path []string) string {
+	return fmt.Sprintf("JSON_EXTRACT(%s, %s)", expr, d.String(jsonPath(path)))
+}
+
+func (sqlite) JSONArrayElements(expr, alias string) string {
+	return fmt.Sprintf("JSON_EACH(%s) AS %s", expr, alias)
+}
+
+// JSONArrayElement returns the value column of JSON_EACH as is
+// for scalars, which it converts to SQL values.
+func (d sqlite) JSONArrayElement(alias string, path []string) string {
+	if len(path) == 0 {
+		return alias + ".value"
+	}
+	return d.JSONExtract(alias+".value", path)
+}
+
+func (sqlite) JSONArrayLength(expr string) string {
+	return fmt.Sprintf("JSON_ARRAY_LENGTH(%s)", expr)
+}
+
+// JSONValue returns expr as is, as JSON_EXTRACT and JSON_EACH
+// convert scalars to SQL values, with booleans as 1 and 0.
+func (sqlite) JSONValue(expr string, to string) string {
+	return expr
+}
+
+// sqliteTypes are the SQL types of the CEL types of Cast.
+var sqliteTypes = map[string]string{
+	"int":    "INTEGER",
+	"uint":   "INTEGER",
+	"double": "REAL",
+	"string": "TEXT",
+}
+
+func (sqlite) Cast(expr string, to string) string {
+	return fmt.Sprintf("CAST(%s AS %s)", expr, sqliteTypes[to])
+}
+
+// Trunc returns expr as is, as casts to INTEGER truncate.
+func (sqlite) Trunc(expr string) string {
+	return expr
+}
+
+// Timestamp converts expr to the number of seconds since the
+// Unix epoch, as SQLite has no timestamp type.
+func (sqlite) Timestamp(expr string) string {
+	return fmt.Sprintf("UNIXEPOCH(%s, 'subsec')", expr)
+}
+
+func (sqlite) Duration(seconds string) string {
+	return seconds
+}
+
+func (sqlite) Epoch(timestamp string) string {
+	return timestamp
+}
+
+// sqliteFormats are the STRFTIME formats of timestamp parts.
+var sqliteFormats = map[TimestampPart]string{
+	Year:      "%Y",
+	Month:     "%m",
+	Day:       "%d",
+	DayOfWeek: "%w",
+	DayOfYear: "%j",
+	Hour:      "%H",
+	Minute:    "%M",
+	Second:    "%S",
+}
+
+func (sqlite) TimestampPart(timestamp string, part TimestampPart) (string, error) {
+	if part == Millisecond {
+		return fmt.Sprintf("CAST(%s * 1000 AS INTEGER) %% 1000", timestamp), nil
+	}
+	format, ok := sqliteFormats[part]
+	if !ok {
+		return "", fmt.Errorf("unsupported timestamp part: %s", part)
+	}
+	return fmt.Sprintf("CAST(STRFTIME('%s', %s, 'unixepoch') AS INTEGER)", format, timestamp), nil
+}
+
+// postgreSQL generates SQL for PostgreSQL.
+type postgreSQL struct {
+	ansi
+}
+
+func (postgreSQL) Length(expr string) string {
+	return fmt.Sprintf("LENGTH(%s)", expr)
+}
+
+func (postgreSQL) Regexp(expr, pattern string, insensitive bool) (string, error) {
+	if insensitive {
+		return fmt.Sprintf("%s ~* %s", expr, pattern), nil
+	}
+	return fmt.Sprintf("%s ~ %s", expr, pattern), nil
+}
+
+// JSONExtract extracts the value as text, which is
+// parenthesized as #>> binds more loosely than most operators.
+func (d postgreSQL) JSONExtract(expr string, path []string) string {
+	keys := make([]string, len(path))
+	for i, key := range path {
+		keys[i] = key
+		if !jsonKey.MatchString(key) {
+			keys[i] = strconv.Quote(key)
+		}
+	}
+	return fmt.Sprintf("(%s #>> %s)", expr, d.String("{"+strings.Join(keys, ",")+"}"))
+}
+
+func (postgreSQL) JSONArrayElements(expr, alias string) string {
+	return fmt.Sprintf("JSONB_ARRAY_ELEMENTS(CAST(%s AS JSONB)) AS %s(value)", expr, alias)
+}
+
+func (d postgreSQL) JSONArrayElement(alias string, path []string) string {
+	return d.JSONExtract(alias+".value", path)
+}
+
+func (postgreSQL) JSONArrayLength(expr string) string {
+	return fmt.Sprintf("JSONB_ARRAY_LENGTH(CAST(%s AS JSONB))", expr)
+}
+
+// JSONValue casts the text that #>> returns.
+func (postgreSQL) JSONValue(expr string, to string) string {
+	if to == "bool" {
+		return fmt.Sprintf("CAST(%s AS BOOLEAN)", expr)
+	}
+	return fmt.Sprintf("CAST(%s AS %s)", expr, postgreSQLTypes[to])
+}
+
+func (postgreSQL) Bytes(b []byte) string {
+	return fmt.Sprintf(`'\x%x'::BYTEA`, b)
+}
+
+// postgreSQLTypes are the SQL types of the CEL types of Cast.
+var postgreSQLTypes = map[string]string{
+	"int":    "BIGINT",
+	"uint":   "BIGINT",
+	"double": "DOUBLE PRECISION",
+	"string": "TEXT",
+}
+
+func (postgreSQL) Cast(expr string, to string) string {
+	return fmt.Sprintf("CAST(%s AS %s)", expr, postgreSQLTypes[to])
+}
+
+func (postgreSQL) TimestampPart(timestamp string, part TimestampPart) (string, error) {
+	utc := timestamp + " AT TIME ZONE 'UTC'"
+	switch part {
+	case Second:
+		return fmt.Sprintf("FLOOR(EXTRACT(SECOND FROM %s))", utc), nil
+	case Millisecond:
+		return fmt.Sprintf("MOD(FLOOR(EXTRACT(MILLISECONDS FROM %s)), 1000)", utc), nil
+	}
+	return fmt.Sprintf("EXTRACT(%s FROM %s)", part, utc), nil
+}
+
+// mySQL generates SQL for MySQL 8. Backslashes escape in its
+// string literals, and its LIKE ignores case under the default
+// collation.
+type mySQL struct {
+	ansi
+}
+
+func (mySQL) QuoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func (mySQL) String(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `''`).Replace(s) + "'"
+}
+
+func (mySQL) Concat(exprs ...string) string {
+	return "CONCAT(" + strings.Join(exprs, ", ") + ")"
+}
+
+func (mySQL) EscapeWildcardsExpr(expr string) string {
+	return fmt.Sprintf(`REPLACE(REPLACE(REPLACE(%s, '\\', '\\\\'), '%%', '\\%%'), '_', '\\_')`, expr)
+}
+
+func (mySQL) Match(expr, pattern string) string {
+	return fmt.Sprintf(`%s LIKE %s COLLATE utf8mb4_bin ESCAPE '\\'`, expr, pattern)
+}
+
+func (mySQL) Regexp(expr, pattern string, insensitive bool) (string, error) {
+	flags := "c"
+	if insensitive {
+		flags = "i"
+	}
+	return fmt.Sprintf("REGEXP_LIKE(%s, %s, '%s')", expr, pattern, flags), nil
+}
+
+func (d mySQL) JSONExtract(expr string, path []string) string {
+	return fmt.Sprintf("JSON_UNQUOTE(JSON_EXTRACT(%s, %s))", expr, d.String(jsonPath(path)))
+}
+
+func (d mySQL) JSONArrayElement(alias string, path []string) string {
+	return d.JSONExtract(alias+".value", path)
+}
+
+func (mySQL) JSONArrayLength(expr string) string {
+	return fmt.Sprintf("JSON_LENGTH(%s)", expr)
+}
+
+// JSONValue casts the text that JSON_UNQUOTE returns. MySQL
+// has no boolean type, so booleans are compared to their JSON
+// text, giving 1 or 0.
+func (mySQL) JSONValue(expr string, to string) string {
+	if to == "bool" {
+		return fmt.Sprintf("(%s = 'true')", expr)
+	}
+	return fmt.Sprintf("CAST(%s AS %s)", expr, mySQLTypes[to])
+}
+
+// mySQLTypes are the SQL types of the CEL types of Cast.
+var mySQLTypes = map[string]string{
+	"int":    "SIGNED",
+	"uint":   "UNSIGNED",
+	"double": "DOUBLE",
+	"string": "CHAR",
+}
+
+func (mySQL) Cast(expr string, to string) string {
+	return fmt.Sprintf("CAST(%s AS %s)", expr, mySQLTypes[to])
+}
+
+func (mySQL) Trunc(expr string) string {
+	return fmt.Sprintf("TRUNCATE(%s, 0)", expr)
+}
+
+func (mySQL) Timestamp(expr string) string {
+	return fmt.Sprintf("CAST(%s AS DATETIME(6))", expr)
+}
+
+// Duration returns an interval, which MySQL only allows to be
+// added to or subtracted from timestamps.
+func (mySQL) Duration(seconds string) string {
+	return fmt.Sprintf("INTERVAL %s SECOND", seconds)
+}
+
+func (mySQL) DurationValues() bool {
+	return false
+}
+
+func (mySQL) Epoch(timestamp string) string {
+	return fmt.Sprintf("UNIX_TIMESTAMP(%s)", timestamp)
+}
+
+// mySQLFunctions are the functions that return timestamp parts.
+var mySQLFunctions = map[TimestampPart]string{
+	Year:      "YEAR",
+	Month:     "MONTH",
+	Day:       "DAY",
+	DayOfYear: "DAYOFYEAR",
+	Hour:      "HOUR",
+	Minute:    "MINUTE",
+	Second:    "SECOND",
+}
+
+func (mySQL) TimestampPart(timestamp string, part TimestampPart) (string, error) {
+	switch part {
+	case DayOfWeek:
+		return fmt.Sprintf("(DAYOFWEEK(%s) - 1)", timestamp), nil
+	case Millisecond:
+		return fmt.Sprintf("FLOOR(MICROSECOND(%s) / 1000)", timestamp), nil
+	}
+	return fmt.Sprintf("%s(%s)", mySQLFunctions[part], timestamp), nil
+}
@@ -0,0 +1,168 @@
+package cel2ansisql
+
+import (
+	"testing"
+
+	"github.com/google/cel-go/cel"
+)
+
+func TestDialects(t *testing.T) {
+	env, err := cel.NewEnv(
+		cel.Variable("path", cel.StringType),
+		cel.Variable("description", cel.StringType),
+		cel.Variable("edition", cel.IntType),
+		cel.Variable("in_stock", cel.BoolType),
+	)
+	if err != nil {
+		t.Fatalf("failed to create CEL environment: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected map[Dialect]string
+	}{
+		{
+			name:  "starts with",
+			input: "description.startsWith('50%_[off]')",
+			expected: map[Dialect]string{
+				ANSI:       `description LIKE '50\%\_[off]%' ESCAPE '\'`,
+				SQLite:     `description GLOB '50%_[[]off]*'`,
+				PostgreSQL: `description LIKE '50\%\_[off]%' ESCAPE '\'`,
+				MySQL:      `description LIKE '50\\%\\_[off]%' COLLATE utf8mb4_bin ESCAPE '\\'`,
+			},
+		},
+		{
+			name:  "contains",
+			input: "description.contains('a*b?')",
+			expected: map[Dialect]string{
+				ANSI:       `description LIKE '%a*b?%' ESCAPE '\'`,
+				SQLite:     `description GLOB '*a[*]b[?]*'`,
+				PostgreSQL: `description LIKE '%a*b?%' ESCAPE '\'`,
+				MySQL:      `description LIKE '%a*b?%' COLLATE utf8mb4_bin ESCAPE '\\'`,
+			},
+		},
+		{
+			name:  "ends with an expression",
+			input: "description.endsWith(path)",
+			expected: map[Dialect]string{
+				ANSI:       `description LIKE ('%' || REPLACE(REPLACE(REPLACE(path, '\', '\\'), '%', '\%'), '_', '\_')) ESCAPE '\'`,
+				SQLite:     `description GLOB ('*' || REPLACE(REPLACE(REPLACE(path, '[', '[[]'), '*', '[*]'), '?', '[?]'))`,
+				PostgreSQL: `description LIKE ('%' || REPLACE(REPLACE(REPLACE(path, '\', '\\'), '%', '\%'), '_', '\_')) ESCAPE '\'`,
+				MySQL:      `description LIKE CONCAT('%', REPLACE(REPLACE(REPLACE(path, '\\', '\\\\'), '%', '\\%'), '_', '\\_')) COLLATE utf8mb4_bin ESCAPE '\\'`,
+			},
+		},
+		{
+			name:  "matches",
+			input: "path.matches('^publishers/[0-9]+$')",
+			expected: map[Dialect]string{
+				ANSI:       `path LIKE_REGEX '^publishers/[0-9]+$'`,
+				SQLite:     `path REGEXP '^publishers/[0-9]+$'`,
+				PostgreSQL: `path ~ '^publishers/[0-9]+$'`,
+				MySQL:      `REGEXP_LIKE(path, '^publishers/[0-9]+$', 'c')`,
+			},
+		},
+		{
+			name:  "matches ignoring case",
+			input: "matches(description, '(?i)^tomorrow')",
+			expected: map[Dialect]string{
+				ANSI:       `description LIKE_REGEX '^tomorrow' FLAG 'i'`,
+				SQLite:     `description REGEXP ('(?i)' || '^tomorrow')`,
+				PostgreSQL: `description ~* '^tomorrow'`,
+				MySQL:      `REGEXP_LIKE(description, '^tomorrow', 'i')`,
+			},
+		},
+		{
+			name:  "string concatenation",
+			input: "path + '/' + description == 'a\\\\b'",
+			expected: map[Dialect]string{
+				ANSI:       `((path || '/') || description) = 'a\b'`,
+				SQLite:     `((path || '/') || description) = 'a\b'`,
+				PostgreSQL: `((path || '/') || description) = 'a\b'`,
+				MySQL:      `CONCAT(CONCAT(path, '/'), description) = 'a\\b'`,
+			},
+		},
+		{
+			name:  "booleans",
+			input: "in_stock == true || in_stock != false",
+			expected: map[Dialect]string{
+				ANSI:       `in_stock = TRUE OR in_stock <> FALSE`,
+				SQLite:     `in_stock = 1 OR in_stock <> 0`,
+				PostgreSQL: `in_stock = TRUE OR in_stock <> FALSE`,
+				MySQL:      `in_stock = TRUE OR in_stock <> FALSE`,
+			},
+		},
+		{
+			name:  "size",
+			input: "size(description) > 10",
+			expected: map[Dialect]string{
+				ANSI:       `CHAR_LENGTH(description) > 10`,
+				SQLite:     `LENGTH(description) > 10`,
+				PostgreSQL: `LENGTH(description) > 10`,
+				MySQL:      `CHAR_LENGTH(description) > 10`,
+			},
+		},
+		{
+			name:  "precedence",
+			input: "(edition + 1) * 2 == 4 && !(path == 'a' || edition - (1 - 2) > 0)",
+			expected: map[Dialect]string{
+				ANSI:       `(edition + 1) * 2 = 4 AND NOT (path = 'a' OR edition - (1 - 2) > 0)`,
+				SQLite:     `(edition + 1) * 2 = 4 AND NOT (path = 'a' OR edition - (1 - 2) > 0)`,
+				PostgreSQL: `(edition + 1) * 2 = 4 AND NOT (path = 'a' OR edition - (1 - 2) > 0)`,
+				MySQL:      `(edition + 1) * 2 = 4 AND NOT (path = 'a' OR edition - (1 - 2) > 0)`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		ast, iss := env.Compile(tt.input)
+		if iss.Err() != nil {
+			t.Fatalf("compile(%q) = %v", tt.input, iss.Err())
+		}
+		for dialect, expected := range tt.expected {
+			t.Run(tt.name+"/"+dialectName(dialect), func(t *testing.T) {
+				got, _, err := Converter{Dialect: dialect}.Convert(ast)
+				if err != nil {
+					t.Fatalf("Convert() = %v, want %v", err, expected)
+				}
+				if got != expected {
+					t.Errorf("Convert() = %v, want %v", got, expected)
+				}
+			})
+		}
+	}
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	tests := []struct {
+		dialect  Dialect
+		input    string
+		expected string
+	}{
+		{ANSI, "order", `"order"`},
+		{SQLite, `a"b`, `"a""b"`},
+		{PostgreSQL, "select", `"select"`},
+		{MySQL, "a`b", "`a``b`"},
+	}
+	for _, tt := range tests {
+		t.Run(dialectName(tt.dialect), func(t *testing.T) {
+			if got := tt.dialect.QuoteIdentifier(tt.input); got != tt.expected {
+				t.Errorf("QuoteIdentifier(%q) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func dialectName(d Dialect) string {
+	switch d {
+	case ANSI:
+		return "ansi"
+	case SQLite:
+		return "sqlite"
+	case PostgreSQL:
+		return "postgresql"
+	case MySQL:
+		return "mysql"
+	}
+	return "unknown"
+}
@@ -0,0 +1,200 @@
+package cel2ansisql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+	"github.com/aep-dev/aepc/loader"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+)
+
+// Field maps a field that CEL expressions may reference to SQL.
+type Field struct {
+	// Type is the CEL type of the field.
+	Type *cel.Type
+	// Column is the column that stores the field.
+	Column string
+	// Path, if set, is the path of the field in the JSON
+	// document stored in Column.
+	Path []string
+}
+
+// sql returns the SQL expression of the field. Numbers and
+// booleans extracted from JSON, and timestamps, are converted to
+// those of the dialect.
+func (f Field) sql(d Dialect) string {
+	sql := d.QuoteIdentifier(f.Column)
+	if len(f.Path) > 0 {
+		sql = d.JSONExtract(sql, f.Path)
+	}
+	if f.Type == nil {
+		return sql
+	}
+	if to, ok := jsonValueTypes[f.Type.Kind()]; ok && len(f.Path) > 0 {
+		return d.JSONValue(sql, to)
+	}
+	if f.Type.Kind() == types.TimestampKind {
+		return d.Timestamp(sql)
+	}
+	return sql
+}
+
+// jsonValueTypes are the names, as taken by Dialect.JSONValue,
+// of the types of the JSON scalars that are converted.
+var jsonValueTypes = map[types.Kind]string{
+	types.IntKind:    "int",
+	types.UintKind:   "uint",
+	types.DoubleKind: "double",
+	types.BoolKind:   "bool",
+}
+
+// Fields maps the names of fields, such as description or, for
+// a nested field, author.given_name, to SQL.
+type Fields map[string]Field
+
+// EnvOptions declares the fields as variables of a CEL
+// environment.
+func (f Fields) EnvOptions() []cel.EnvOption {
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	opts := make([]cel.EnvOption, 0, len(names))
+	for _, name := range names {
+		opts = append(opts, cel.Variable(name, f[name].Type))
+	}
+	return opts
+}
+
+// NewEnv returns a CEL environment declaring the fields, and
+// configured by opts.
+func (f Fields) NewEnv(opts ...cel.EnvOption) (*cel.Env, error) {
+	return cel.NewEnv(append(f.EnvOptions(), opts...)...)
+}
+
+// ResourceFields returns the fields of the resource named
+// resource in a. Each property of the resource is stored in the
+// column of the same name. The properties of object properties
+// are fields of their own, extracted from the JSON document
+// stored in the column of the object.
+func ResourceFields(a *api.API, resource string) (Fields, error) {
+	r, ok := a.Resources[resource]
+	if !ok {
+		return nil, fmt.Errorf("resource %q not found", resource)
+	}
+	if r.Schema == nil {
+		return nil, fmt.Errorf("resource %q has no schema", resource)
+	}
+	w := &fieldWalker{schemas: loader.NamedSchemas(a), fields: Fields{}}
+	if err := w.walkProperties(r.Schema, "", nil, []string{resource}); err != nil {
+		return nil, err
+	}
+	return w.fields, nil
+}
+
+type fieldWalker struct {
+	schemas map[string]*openapi.Schema
+	fields  Fields
+}
+
+// walkProperties adds a field for each property of s, stored
+// in column at path. refs are the schemas being walked, to
+// detect reference cycles.
+func (w *fieldWalker) walkProperties(s *openapi.Schema, column string, path []string, refs []string) error {
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		p := s.Properties[name]
+		c, fieldPath := column, append(append([]string{}, path...), name)
+		if c == "" {
+			c, fieldPath = name, nil
+		}
+		if err := w.walkProperty(&p, c, fieldPath, refs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *fieldWalker) walkProperty(p *openapi.Schema, column string, path []string, refs []string) error {
+	name := strings.Join(append([]string{column}, path...), ".")
+	p, refs, err := w.deref(p, refs)
+	if err != nil {
+		return fmt.Errorf("field %s: %w", name, err)
+	}
+	if p.Type == "object" && len(p.Properties) > 0 {
+		return w.walkProperties(p, column, path, refs)
+	}
+	t, err := w.celType(p, refs)
+	if err != nil {
+		return fmt.Errorf("field %s: %w", name, err)
+	}
+	w.fields[name] = Field{Type: t, Column: column, Path: path}
+	return nil
+}
+
+// deref returns the schema s references, if any, and refs
+// followed by its name.
+func (w *fieldWalker) deref(s *openapi.Schema, refs []string) (*openapi.Schema, []string, error) {
+	if s.Ref == "" {
+		return s, refs, nil
+	}
+	name, ok := strings.CutPrefix(s.Ref, loader.SchemaRefPrefix)
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported reference %q", s.Ref)
+	}
+	for _, ref := range refs {
+		if ref == name {
+			return nil, nil, fmt.Errorf("schema reference cycle through %q", name)
+		}
+	}
+	target, ok := w.schemas[name]
+	if !ok || target == nil {
+		return nil, nil, fmt.Errorf("reference to undeclared schema %q", name)
+	}
+	return target, append(append([]string{}, refs...), name), nil
+}
+
+// celType returns the CEL type of values of s. Strings in the
+// date-time format are timestamps. Objects are maps, as their
+// properties can only be reached through CEL macros and
+// functions.
+func (w *fieldWalker) celType(s *openapi.Schema, refs []string) (*cel.Type, error) {
+	s, refs, err := w.deref(s, refs)
+	if err != nil {
+		return nil, err
+	}
+	switch s.Type {
+	case "string":
+		if s.Format == "date-time" {
+			return cel.TimestampType, nil
+		}
+		return cel.StringType, nil
+	case "integer":
+		return cel.IntType, nil
+	case "number":
+		return cel.DoubleType, nil
+	case "boolean":
+		return cel.BoolType, nil
+	case "object":
+		return cel.MapType(cel.StringType, cel.DynType), nil
+	case "array":
+		if s.Items == nil {
+			return cel.ListType(cel.DynType), nil
+		}
+		t, err := w.celType(s.Items, refs)
+		if err != nil {
+			return nil, err
+		}
+		return cel.ListType(t), nil
+	}
+	return nil, fmt.Errorf("unsupported type %q", s.Type)
+}
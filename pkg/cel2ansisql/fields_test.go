@@ -0,0 +1,302 @@
+package cel2ansisql
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+	"github.com/google/cel-go/cel"
+)
+
+func TestFields(t *testing.T) {
+	fields := Fields{
+		"path":              {Type: cel.StringType, Column: "path"},
+		"order":             {Type: cel.IntType, Column: "order"},
+		"author.given_name": {Type: cel.StringType, Column: "author", Path: []string{"given_name"}},
+		"author.age":        {Type: cel.IntType, Column: "author", Path: []string{"age"}},
+		"author.rating":     {Type: cel.DoubleType, Column: "author", Path: []string{"rating"}},
+		"author.verified":   {Type: cel.BoolType, Column: "author", Path: []string{"verified"}},
+		"isbn":              {Type: cel.ListType(cel.StringType), Column: "isbn"},
+		"editions":          {Type: cel.ListType(cel.IntType), Column: "editions"},
+		"authors":           {Type: cel.ListType(cel.MapType(cel.StringType, cel.DynType)), Column: "authors"},
+		"metadata":          {Type: cel.MapType(cel.StringType, cel.DynType), Column: "metadata"},
+		"author.address.zip_code": {
+			Type:   cel.StringType,
+			Column: "author",
+			Path:   []string{"address", "zip-code"},
+		},
+	}
+	env, err := fields.NewEnv(cel.Variable("undeclared", cel.StringType))
+	if err != nil {
+		t.Fatalf("failed to create CEL environment: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected map[Dialect]string
+		wantErr  string
+	}{
+		{
+			name:  "columns are quoted",
+			input: "path == 'a' && order > 1",
+			expected: map[Dialect]string{
+				ANSI:   `"path" = 'a' AND "order" > 1`,
+				SQLite: `"path" = 'a' AND "order" > 1`,
+				MySQL:  "`path` = 'a' AND `order` > 1",
+			},
+		},
+		{
+			name:  "nested fields are extracted from JSON",
+			input: "author.given_name.startsWith('J')",
+			expected: map[Dialect]string{
+				ANSI:       `JSON_VALUE("author", '$.given_name') LIKE 'J%' ESCAPE '\'`,
+				SQLite:     `JSON_EXTRACT("author", '$.given_name') GLOB 'J*'`,
+				PostgreSQL: `("author" #>> '{given_name}') LIKE 'J%' ESCAPE '\'`,
+				MySQL:      "JSON_UNQUOTE(JSON_EXTRACT(`author`, '$.given_name')) LIKE 'J%' COLLATE utf8mb4_bin ESCAPE '\\\\'",
+			},
+		},
+		{
+			name:  "keys are quoted in paths",
+			input: "author.address.zip_code == '1'",
+			expected: map[Dialect]string{
+				SQLite:     `JSON_EXTRACT("author", '$.address."zip-code"') = '1'`,
+				PostgreSQL: `("author" #>> '{address,"zip-code"}') = '1'`,
+			},
+		},
+		{
+			name:  "nested numbers and booleans are converted",
+			input: "author.age > 30 && author.rating >= 4.5 && author.verified",
+			expected: map[Dialect]string{
+				ANSI:       `CAST(JSON_VALUE("author", '$.age') AS BIGINT) > 30 AND CAST(JSON_VALUE("author", '$.rating') AS DOUBLE PRECISION) >= 4.5 AND CAST(JSON_VALUE("author", '$.verified') AS BOOLEAN)`,
+				SQLite:     `JSON_EXTRACT("author", '$.age') > 30 AND JSON_EXTRACT("author", '$.rating') >= 4.5 AND JSON_EXTRACT("author", '$.verified')`,
+				PostgreSQL: `CAST(("author" #>> '{age}') AS BIGINT) > 30 AND CAST(("author" #>> '{rating}') AS DOUBLE PRECISION) >= 4.5 AND CAST(("author" #>> '{verified}') AS BOOLEAN)`,
+				MySQL:      "CAST(JSON_UNQUOTE(JSON_EXTRACT(`author`, '$.age')) AS SIGNED) > 30 AND CAST(JSON_UNQUOTE(JSON_EXTRACT(`author`, '$.rating')) AS DOUBLE) >= 4.5 AND (JSON_UNQUOTE(JSON_EXTRACT(`author`, '$.verified')) = 'true')",
+			},
+		},
+		{
+			name:  "nested fields compared to each other",
+			input: "author.age == int(author.rating) || !author.verified",
+			expected: map[Dialect]string{
+				PostgreSQL: `CAST(("author" #>> '{age}') AS BIGINT) = CAST(TRUNC(CAST(("author" #>> '{rating}') AS DOUBLE PRECISION)) AS BIGINT) OR NOT (CAST(("author" #>> '{verified}') AS BOOLEAN))`,
+			},
+		},
+		{
+			name:  "selection from a map",
+			input: "metadata.cover.color == 'red' && has(metadata.pages)",
+			expected: map[Dialect]string{
+				SQLite:     `JSON_EXTRACT("metadata", '$.cover.color') = 'red' AND JSON_EXTRACT("metadata", '$.pages') IS NOT NULL`,
+				PostgreSQL: `("metadata" #>> '{cover,color}') = 'red' AND ("metadata" #>> '{pages}') IS NOT NULL`,
+			},
+		},
+		{
+			name:  "in a JSON array",
+			input: "'0-13-110362-8' in isbn",
+			expected: map[Dialect]string{
+				ANSI:       `EXISTS (SELECT 1 FROM JSON_TABLE("isbn", '$[*]' COLUMNS (value JSON PATH '$')) AS e1 WHERE JSON_VALUE(e1.value, '$') = '0-13-110362-8')`,
+				SQLite:     `EXISTS (SELECT 1 FROM JSON_EACH("isbn") AS e1 WHERE e1.value = '0-13-110362-8')`,
+				PostgreSQL: `EXISTS (SELECT 1 FROM JSONB_ARRAY_ELEMENTS(CAST("isbn" AS JSONB)) AS e1(value) WHERE (e1.value #>> '{}') = '0-13-110362-8')`,
+				MySQL:      "EXISTS (SELECT 1 FROM JSON_TABLE(`isbn`, '$[*]' COLUMNS (value JSON PATH '$')) AS e1 WHERE JSON_UNQUOTE(JSON_EXTRACT(e1.value, '$')) = '0-13-110362-8')",
+			},
+		},
+		{
+			name:  "size of a JSON array",
+			input: "size(isbn) > 1 && size(path) > 1",
+			expected: map[Dialect]string{
+				ANSI:       `JSON_VALUE("isbn", '$.size()' RETURNING BIGINT) > 1 AND CHAR_LENGTH("path") > 1`,
+				SQLite:     `JSON_ARRAY_LENGTH("isbn") > 1 AND LENGTH("path") > 1`,
+				PostgreSQL: `JSONB_ARRAY_LENGTH(CAST("isbn" AS JSONB)) > 1 AND LENGTH("path") > 1`,
+				MySQL:      "JSON_LENGTH(`isbn`) > 1 AND CHAR_LENGTH(`path`) > 1",
+			},
+		},
+		{
+			name:  "elements of a JSON array of numbers are converted",
+			input: "editions.exists(e, e > 2) || 3 in editions",
+			expected: map[Dialect]string{
+				SQLite:     `EXISTS (SELECT 1 FROM JSON_EACH("editions") AS e1 WHERE e1.value > 2) OR EXISTS (SELECT 1 FROM JSON_EACH("editions") AS e2 WHERE e2.value = 3)`,
+				PostgreSQL: `EXISTS (SELECT 1 FROM JSONB_ARRAY_ELEMENTS(CAST("editions" AS JSONB)) AS e1(value) WHERE CAST((e1.value #>> '{}') AS BIGINT) > 2) OR EXISTS (SELECT 1 FROM JSONB_ARRAY_ELEMENTS(CAST("editions" AS JSONB)) AS e2(value) WHERE CAST((e2.value #>> '{}') AS BIGINT) = 3)`,
+				MySQL:      "EXISTS (SELECT 1 FROM JSON_TABLE(`editions`, '$[*]' COLUMNS (value JSON PATH '$')) AS e1 WHERE CAST(JSON_UNQUOTE(JSON_EXTRACT(e1.value, '$')) AS SIGNED) > 2) OR EXISTS (SELECT 1 FROM JSON_TABLE(`editions`, '$[*]' COLUMNS (value JSON PATH '$')) AS e2 WHERE CAST(JSON_UNQUOTE(JSON_EXTRACT(e2.value, '$')) AS SIGNED) = 3)",
+			},
+		},
+		{
+			name:  "exists",
+			input: "authors.exists(a, a.given_name == 'Ann')",
+			expected: map[Dialect]string{
+				SQLite:     `EXISTS (SELECT 1 FROM JSON_EACH("authors") AS e1 WHERE JSON_EXTRACT(e1.value, '$.given_name') = 'Ann')`,
+				PostgreSQL: `EXISTS (SELECT 1 FROM JSONB_ARRAY_ELEMENTS(CAST("authors" AS JSONB)) AS e1(value) WHERE (e1.value #>> '{given_name}') = 'Ann')`,
+			},
+		},
+		{
+			name:  "all",
+			input: "path == 'a' || isbn.all(i, i.startsWith('0'))",
+			expected: map[Dialect]string{
+				SQLite: `"path" = 'a' OR NOT EXISTS (SELECT 1 FROM JSON_EACH("isbn") AS e1 WHERE NOT (e1.value GLOB '0*'))`,
+			},
+		},
+		{
+			name:  "nested comprehensions",
+			input: "authors.exists(a, isbn.exists(a, a == '1') && a.given_name == 'Ann')",
+			expected: map[Dialect]string{
+				SQLite: `EXISTS (SELECT 1 FROM JSON_EACH("authors") AS e1 WHERE EXISTS (SELECT 1 FROM JSON_EACH("isbn") AS e2 WHERE e2.value = '1') AND JSON_EXTRACT(e1.value, '$.given_name') = 'Ann')`,
+			},
+		},
+		{
+			name:    "other macros are rejected",
+			input:   "isbn.exists_one(i, i == '1')",
+			wantErr: "unsupported comprehension",
+		},
+		{
+			name:    "unknown fields are rejected",
+			input:   "undeclared == 'a'",
+			wantErr: `unknown field "undeclared"`,
+		},
+	}
+
+	for _, tt := range tests {
+		ast, iss := env.Compile(tt.input)
+		if iss.Err() != nil {
+			t.Fatalf("compile(%q) = %v", tt.input, iss.Err())
+		}
+		if tt.wantErr != "" {
+			_, _, err := Converter{Fields: fields}.Convert(ast)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Convert(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+			continue
+		}
+		for dialect, expected := range tt.expected {
+			t.Run(tt.name+"/"+dialectName(dialect), func(t *testing.T) {
+				got, _, err := Converter{Dialect: dialect, Fields: fields}.Convert(ast)
+				if err != nil {
+					t.Fatalf("Convert() = %v, want %v", err, expected)
+				}
+				if got != expected {
+					t.Errorf("Convert() = %v, want %v", got, expected)
+				}
+			})
+		}
+	}
+}
+
+func TestResourceFields(t *testing.T) {
+	newAPI := func(schema *openapi.Schema, schemas map[string]*openapi.Schema) *api.API {
+		return &api.API{
+			Schemas:   schemas,
+			Resources: map[string]*api.Resource{"book": {Singular: "book", Plural: "books", Schema: schema}},
+		}
+	}
+	author := &openapi.Schema{
+		Type: "object",
+		Properties: openapi.Properties{
+			"given_name": {Type: "string"},
+			"address": {
+				Type:       "object",
+				Properties: openapi.Properties{"city": {Type: "string"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		api      *api.API
+		resource string
+		want     Fields
+		wantErr  string
+	}{
+		{
+			name: "scalars, lists and nested objects",
+			api: newAPI(&openapi.Schema{
+				Type: "object",
+				Properties: openapi.Properties{
+					"path":        {Type: "string"},
+					"edition":     {Type: "integer"},
+					"price":       {Type: "number"},
+					"published":   {Type: "boolean"},
+					"isbn":        {Type: "array", Items: &openapi.Schema{Type: "string"}},
+					"author":      *author,
+					"metadata":    {Type: "object"},
+					"create_time": {Type: "string", Format: "date-time"},
+				},
+			}, nil),
+			resource: "book",
+			want: Fields{
+				"path":                {Type: cel.StringType, Column: "path"},
+				"edition":             {Type: cel.IntType, Column: "edition"},
+				"price":               {Type: cel.DoubleType, Column: "price"},
+				"published":           {Type: cel.BoolType, Column: "published"},
+				"isbn":                {Type: cel.ListType(cel.StringType), Column: "isbn"},
+				"author.given_name":   {Type: cel.StringType, Column: "author", Path: []string{"given_name"}},
+				"author.address.city": {Type: cel.StringType, Column: "author", Path: []string{"address", "city"}},
+				"metadata":            {Type: cel.MapType(cel.StringType, cel.DynType), Column: "metadata"},
+				"create_time":         {Type: cel.TimestampType, Column: "create_time"},
+			},
+		},
+		{
+			name: "references",
+			api: newAPI(&openapi.Schema{
+				Type: "object",
+				Properties: openapi.Properties{
+					"author":  {Type: "object", Ref: "#/components/schemas/author"},
+					"authors": {Type: "array", Items: &openapi.Schema{Ref: "#/components/schemas/author"}},
+				},
+			}, map[string]*openapi.Schema{"author": author}),
+			resource: "book",
+			want: Fields{
+				"author.given_name":   {Type: cel.StringType, Column: "author", Path: []string{"given_name"}},
+				"author.address.city": {Type: cel.StringType, Column: "author", Path: []string{"address", "city"}},
+				"authors":             {Type: cel.ListType(cel.MapType(cel.StringType, cel.DynType)), Column: "authors"},
+			},
+		},
+		{
+			name: "reference cycle",
+			api: newAPI(&openapi.Schema{
+				Type: "object",
+				Properties: openapi.Properties{
+					"node": {Type: "object", Ref: "#/components/schemas/node"},
+				},
+			}, map[string]*openapi.Schema{"node": {
+				Type: "object",
+				Properties: openapi.Properties{
+					"next": {Type: "object", Ref: "#/components/schemas/node"},
+				},
+			}}),
+			resource: "book",
+			wantErr:  `field node.next: schema reference cycle through "node"`,
+		},
+		{
+			name: "unsupported type",
+			api: newAPI(&openapi.Schema{
+				Type:       "object",
+				Properties: openapi.Properties{"cover": {Type: "file"}},
+			}, nil),
+			resource: "book",
+			wantErr:  `field cover: unsupported type "file"`,
+		},
+		{
+			name:     "unknown resource",
+			api:      newAPI(&openapi.Schema{Type: "object"}, nil),
+			resource: "publisher",
+			wantErr:  `resource "publisher" not found`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResourceFields(tt.api, tt.resource)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("ResourceFields() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResourceFields() = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ResourceFields() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 #!/usr/bin/env bash
 set -e
+go run main.go fmt --check ./example/bookstore/v1/bookstore.yaml
+go test ./golden/
 ./scripts/regenerate-all.sh
 if git diff --exit-code; then
     echo "No differences found."
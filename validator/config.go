@@ -0,0 +1,173 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ghodss/yaml"
+)
+
+// ConfigFile is the name of the file configuring the validator,
+// looked up next to the definition.
+const ConfigFile = "aepc.yaml"
+
+// Config tunes the rules of the validator for an API. It is
+// read from ConfigFile, or from the x-aep-lint key of the
+// definition.
+type Config struct {
+	// Rules configures rules by ID.
+	Rules map[string]RuleConfig `json:"rules,omitempty"`
+	// Suppressions silence the diagnostics of a rule for a
+	// single resource, field or other part of the definition.
+	Suppressions []Suppression `json:"suppressions,omitempty"`
+}
+
+// RuleConfig configures a single rule.
+type RuleConfig struct {
+	// Disabled prevents the rule from reporting diagnostics.
+	Disabled bool `json:"disabled,omitempty"`
+	// Severity, if set, replaces the severity of the rule.
+	Severity Severity `json:"severity,omitempty"`
+}
+
+// Suppression silences the diagnostics of a rule located in a
+// resource, in a field of a resource, or under a JSON pointer.
+type Suppression struct {
+	// Rule is the ID of the suppressed rule.
+	Rule string `json:"rule"`
+	// Resource is the name of the resource, as keyed in the
+	// definition.
+	Resource string `json:"resource,omitempty"`
+	// Field optionally restricts the suppression to a field
+	// of the resource.
+	Field string `json:"field,omitempty"`
+	// Location is a JSON pointer into the definition, such as
+	// /schemas/author or /resources/book/custom_methods/0,
+	// that suppresses the diagnostics located at or under it.
+	// It replaces Resource and Field.
+	Location string `json:"location,omitempty"`
+	// Justification records why the rule does not apply.
+	Justification string `json:"justification"`
+}
+
+// ParseConfig parses a Config from YAML or JSON, rejecting
+// unknown keys, rules and severities, fixed rules, and
+// suppressions without a justification.
+func ParseConfig(b []byte) (*Config, error) {
+	asJSON, err := yaml.YAMLToJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &Config{}
+	dec := json.NewDecoder(bytes.NewReader(asJSON))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(cfg); err != nil {
+		return nil, err
+	}
+	for id, rc := range cfg.Rules {
+		r, ok := rules[id]
+		if !ok {
+			return nil, fmt.Errorf("unknown rule %q", id)
+		}
+		if r.Fixed {
+			return nil, fmt.Errorf("rule %q cannot be configured", id)
+		}
+		switch rc.Severity {
+		case "", SeverityError, SeverityWarning, SeverityInfo:
+		default:
+			return nil, fmt.Errorf("rule %q: unknown severity %q", id, rc.Severity)
+		}
+	}
+	for i, s := range cfg.Suppressions {
+		r, ok := rules[s.Rule]
+		if !ok {
+			return nil, fmt.Errorf("suppression %d: unknown rule %q", i, s.Rule)
+		}
+		if r.Fixed {
+			return nil, fmt.Errorf("suppression %d: rule %q cannot be suppressed", i, s.Rule)
+		}
+		switch {
+		case s.Location != "" && (s.Resource != "" || s.Field != ""):
+			return nil, fmt.Errorf("suppression %d: location excludes resource and field", i)
+		case s.Location != "" && !strings.HasPrefix(s.Location, "/"):
+			return nil, fmt.Errorf("suppression %d: location %q is not a JSON pointer", i, s.Location)
+		case s.Location == "" && s.Resource == "":
+			return nil, fmt.Errorf("suppression %d: missing resource or location", i)
+		}
+		if strings.TrimSpace(s.Justification) == "" {
+			return nil, fmt.Errorf("suppression %d: missing justification", i)
+		}
+	}
+	return cfg, nil
+}
+
+// Merge returns a copy of c with the rules and suppressions of
+// o added. Rules configured by both take the configuration of
+// o.
+func (c *Config) Merge(o *Config) *Config {
+	merged := &Config{Rules: map[string]RuleConfig{}}
+	for _, cfg := range []*Config{c, o} {
+		if cfg == nil {
+			continue
+		}
+		for id, rc := range cfg.Rules {
+			merged.Rules[id] = rc
+		}
+		merged.Suppressions = append(merged.Suppressions, cfg.Suppressions...)
+	}
+	return merged
+}
+
+// apply drops the diagnostics of disabled rules, sets the
+// configured severities, and marks suppressed diagnostics.
+func (c *Config) apply(diags []Diagnostic) []Diagnostic {
+	if c == nil {
+		return diags
+	}
+	applied := []Diagnostic{}
+	for _, d := range diags {
+		rc := c.Rules[d.RuleID]
+		if rc.Disabled {
+			continue
+		}
+		if rc.Severity != "" {
+			d.Severity = rc.Severity
+		}
+		for _, s := range c.Suppressions {
+			if s.matches(d) {
+				d.Suppressed = true
+				d.Justification = s.Justification
+				break
+			}
+		}
+		applied = append(applied, d)
+	}
+	return applied
+}
+
+func (s Suppression) matches(d Diagnostic) bool {
+	if s.Rule != d.RuleID {
+		return false
+	}
+	prefix := s.Location
+	if prefix == "" {
+		prefix = Pointer("resources", s.Resource)
+		if s.Field != "" {
+			prefix += Pointer("schema", "properties", s.Field)
+		}
+	}
+	return d.Location == prefix || strings.HasPrefix(d.Location, prefix+"/")
+}
+
+// Suppressed returns the number of suppressed diagnostics.
+func Suppressed(diags []Diagnostic) int {
+	n := 0
+	for _, d := range diags {
+		if d.Suppressed {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,88 @@
+package validator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseConfigErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"unknown key", `colour: blue`},
+		{"unknown rule", `rules: {no-such-rule: {disabled: true}}`},
+		{"unknown severity", `rules: {custom-method-verb: {severity: fatal}}`},
+		{"missing resource", `suppressions: [{rule: custom-method-verb, justification: "legacy"}]`},
+		{"missing justification", `suppressions: [{rule: custom-method-verb, resource: book}]`},
+		{"location and resource", `suppressions: [{rule: custom-method-verb, resource: book, location: /resources/book, justification: "legacy"}]`},
+		{"relative location", `suppressions: [{rule: custom-method-verb, location: resources/book, justification: "legacy"}]`},
+		{"fixed rule", `rules: {resource-parent-unresolved: {disabled: true}}`},
+		{"fixed rule severity", `rules: {resource-parent-cycle: {severity: warning}}`},
+		{"suppressed fixed rule", `suppressions: [{rule: resource-parent-unresolved, resource: book, justification: "legacy"}]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseConfig([]byte(tt.input)); err == nil {
+				t.Errorf("ParseConfig() error = nil, want an error")
+			}
+		})
+	}
+}
+
+func TestConfigApply(t *testing.T) {
+	cfg, err := ParseConfig([]byte(`
+rules:
+  resource-plural-form:
+    disabled: true
+  custom-method-verb:
+    severity: error
+suppressions:
+  - rule: field-reserved-name
+    resource: book
+    field: etag
+    justification: "populated by the storage layer"
+  - rule: field-reserved-name
+    location: /schemas/author
+    justification: "shared with a legacy API"
+  - rule: field-number-duplicate
+    location: /resources/book/custom_methods/0/request
+    justification: "kept for compatibility"
+`))
+	if err != nil {
+		t.Fatalf("ParseConfig() error = %v", err)
+	}
+	diags := cfg.apply([]Diagnostic{
+		newDiagnostic("resource-plural-form", "/resources/child/plural", ""),
+		newDiagnostic("custom-method-verb", "/resources/book/custom_methods/0/name", ""),
+		newDiagnostic("field-reserved-name", "/resources/book/schema/properties/etag", ""),
+		newDiagnostic("field-reserved-name", "/resources/book/schema/properties/id", ""),
+		newDiagnostic("field-reserved-name", "/schemas/author/properties/id", ""),
+		newDiagnostic("field-reserved-name", "/schemas/author-name/properties/id", ""),
+		newDiagnostic("field-number-duplicate", "/resources/book/custom_methods/0/request/properties/a/x-aep-field/field_number", ""),
+	})
+	want := []Diagnostic{
+		newDiagnostic("custom-method-verb", "/resources/book/custom_methods/0/name", ""),
+		newDiagnostic("field-reserved-name", "/resources/book/schema/properties/etag", ""),
+		newDiagnostic("field-reserved-name", "/resources/book/schema/properties/id", ""),
+		newDiagnostic("field-reserved-name", "/schemas/author/properties/id", ""),
+		newDiagnostic("field-reserved-name", "/schemas/author-name/properties/id", ""),
+		newDiagnostic("field-number-duplicate", "/resources/book/custom_methods/0/request/properties/a/x-aep-field/field_number", ""),
+	}
+	want[0].Severity = SeverityError
+	want[1].Suppressed = true
+	want[1].Justification = "populated by the storage layer"
+	want[3].Suppressed = true
+	want[3].Justification = "shared with a legacy API"
+	want[5].Suppressed = true
+	want[5].Justification = "kept for compatibility"
+	if !reflect.DeepEqual(diags, want) {
+		t.Errorf("apply() = %+v, want %+v", diags, want)
+	}
+	if HasErrors(diags[1:2]) {
+		t.Errorf("HasErrors() = true for a suppressed diagnostic")
+	}
+	if n := Suppressed(diags); n != 3 {
+		t.Errorf("Suppressed() = %d, want 3", n)
+	}
+}
@@ -0,0 +1,100 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aep-dev/aepc/loader"
+)
+
+// Severity is the severity of a Diagnostic.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+// Diagnostic is a single finding of the validator.
+type Diagnostic struct {
+	// RuleID identifies the rule that produced the diagnostic.
+	RuleID string `json:"rule_id"`
+	// Severity of the finding.
+	Severity Severity `json:"severity"`
+	// Location is a JSON pointer into the resource definition,
+	// e.g. /resources/book/schema/properties/price.
+	Location string `json:"location"`
+	// Message is a human-readable description of the finding.
+	Message string `json:"message"`
+	// File, Line and Column point at the declaration of Location
+	// in the source of the definition, if known. Line and
+	// Column are 1-based.
+	File   string `json:"file,omitempty"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+	// Suppressed is set if the diagnostic is silenced by a
+	// Suppression of the Config, along with its Justification.
+	Suppressed    bool   `json:"suppressed,omitempty"`
+	Justification string `json:"justification,omitempty"`
+}
+
+func (d Diagnostic) Error() string {
+	if d.Line > 0 {
+		return fmt.Sprintf("%s:%d:%d: %s [%s]", d.File, d.Line, d.Column, d.Message, d.RuleID)
+	}
+	return fmt.Sprintf("%s: %s [%s]", d.Location, d.Message, d.RuleID)
+}
+
+// HasErrors returns true if any diagnostic that is not
+// suppressed has SeverityError.
+func HasErrors(diags []Diagnostic) bool {
+	for _, d := range diags {
+		if d.Severity == SeverityError && !d.Suppressed {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadDiagnostics returns the diagnostics of err, a failure
+// to load a definition, and true if it is a loader.Error or
+// loader.Errors. The rule ID of each is load- followed by the
+// kind of the error, e.g. load-syntax.
+func LoadDiagnostics(err error) ([]Diagnostic, bool) {
+	var loadErrs loader.Errors
+	if !errors.As(err, &loadErrs) {
+		var loadErr *loader.Error
+		if !errors.As(err, &loadErr) {
+			return nil, false
+		}
+		loadErrs = loader.Errors{loadErr}
+	}
+	diags := []Diagnostic{}
+	for _, e := range loadErrs {
+		diags = append(diags, Diagnostic{
+			RuleID:   "load-" + string(e.Kind),
+			Severity: SeverityError,
+			Location: e.Pointer,
+			Message:  e.Msg,
+			File:     e.Position.File,
+			Line:     e.Position.Line,
+			Column:   e.Position.Column,
+		})
+	}
+	return diags, true
+}
+
+// Pointer builds a JSON pointer (RFC 6901) from
+// unescaped reference tokens.
+func Pointer(tokens ...string) string {
+	var b strings.Builder
+	for _, t := range tokens {
+		t = strings.ReplaceAll(t, "~", "~0")
+		t = strings.ReplaceAll(t, "/", "~1")
+		b.WriteString("/")
+		b.WriteString(t)
+	}
+	return b.String()
+}
@@ -0,0 +1,56 @@
+package validator
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/aep-dev/aepc/loader"
+)
+
+var enumValueRegex = regexp.MustCompile("^[A-Z][A-Z0-9_]*$")
+
+// validateEnums returns the diagnostics found with the enums
+// of a definition.
+func validateEnums(d *loader.Definition) []Diagnostic {
+	diags := []Diagnostic{}
+	// enum values are generated in the scope of the message
+	// holding the property, so they must be unique across the
+	// enums of a message.
+	scopes := map[string]map[string]string{}
+	for _, e := range d.Enums {
+		if s := e.Lookup(d.API); s != nil && s.Type != "string" {
+			diags = append(diags, newDiagnostic("enum-type", e.Pointer, fmt.Sprintf("enum must be of type %q, got %q", "string", s.Type)))
+		}
+		if len(e.Values) == 0 {
+			diags = append(diags, newDiagnostic("enum-empty", e.Pointer+Pointer("enum"), "enum must declare at least one value"))
+		}
+		scopeKey := fmt.Sprintf("%v/%s/%s", e.Resource, e.Schema, strings.Join(e.Path[:len(e.Path)-1], "/"))
+		scope, ok := scopes[scopeKey]
+		if !ok {
+			scope = map[string]string{}
+			scopes[scopeKey] = scope
+		}
+		scope[e.UnspecifiedValue()] = e.Property()
+		seen := map[string]bool{}
+		for i, v := range e.Values {
+			loc := e.Pointer + Pointer("enum", strconv.Itoa(i))
+			switch {
+			case !enumValueRegex.MatchString(v):
+				diags = append(diags, newDiagnostic("enum-value-format", loc, fmt.Sprintf("enum value %q must match regex %q", v, enumValueRegex.String())))
+			case v == "UNSPECIFIED" || strings.HasSuffix(v, "_UNSPECIFIED"):
+				diags = append(diags, newDiagnostic("enum-value-reserved", loc, fmt.Sprintf("enum value %q is reserved, %q is generated for unset values", v, e.UnspecifiedValue())))
+			case seen[v]:
+				diags = append(diags, newDiagnostic("enum-value-duplicate", loc, fmt.Sprintf("enum value %q is declared more than once", v)))
+			default:
+				if other, ok := scope[v]; ok && other != e.Property() {
+					diags = append(diags, newDiagnostic("enum-value-conflict", loc, fmt.Sprintf("enum value %q is also declared by sibling property %q", v, other)))
+				}
+				scope[v] = e.Property()
+			}
+			seen[v] = true
+		}
+	}
+	return diags
+}
@@ -0,0 +1,181 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// The supported output formats for WriteDiagnostics.
+const (
+	FormatText  = "text"
+	FormatJSON  = "json"
+	FormatSARIF = "sarif"
+)
+
+// Formats lists the supported output formats.
+var Formats = []string{FormatText, FormatJSON, FormatSARIF}
+
+// WriteDiagnostics writes the diagnostics found in file to w
+// in the given format.
+func WriteDiagnostics(w io.Writer, format, file string, diags []Diagnostic) error {
+	switch format {
+	case FormatText, "":
+		for _, d := range diags {
+			if d.Suppressed {
+				continue
+			}
+			pos := file
+			if d.Line > 0 {
+				pos = fmt.Sprintf("%s:%d:%d", d.File, d.Line, d.Column)
+			}
+			_, err := fmt.Fprintf(w, "%s: %s: %s: %s [%s]\n", pos, d.Severity, d.Location, d.Message, d.RuleID)
+			if err != nil {
+				return err
+			}
+		}
+		if n := Suppressed(diags); n > 0 {
+			_, err := fmt.Fprintf(w, "%s: findings suppressed: %d\n", file, n)
+			return err
+		}
+		return nil
+	case FormatJSON:
+		return writeJSON(w, struct {
+			File        string       `json:"file"`
+			Diagnostics []Diagnostic `json:"diagnostics"`
+		}{File: file, Diagnostics: diags})
+	case FormatSARIF:
+		return writeJSON(w, toSARIF(file, diags))
+	default:
+		return fmt.Errorf("unsupported format %q, must be one of %v", format, Formats)
+	}
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// the subset of the SARIF 2.1.0 schema used by aepc.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID       string             `json:"ruleId"`
+	Level        string             `json:"level"`
+	Message      sarifMessage       `json:"message"`
+	Locations    []sarifLocation    `json:"locations"`
+	Suppressions []sarifSuppression `json:"suppressions,omitempty"`
+}
+
+type sarifSuppression struct {
+	Kind          string `json:"kind"`
+	Justification string `json:"justification,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+func toSARIF(file string, diags []Diagnostic) sarifLog {
+	ruleIDs := map[string]bool{}
+	results := []sarifResult{}
+	for _, d := range diags {
+		ruleIDs[d.RuleID] = true
+		physical := sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: file},
+		}
+		if d.Line > 0 {
+			physical.ArtifactLocation.URI = d.File
+			physical.Region = &sarifRegion{StartLine: d.Line, StartColumn: d.Column}
+		}
+		result := sarifResult{
+			RuleID:  d.RuleID,
+			Level:   sarifLevel(d.Severity),
+			Message: sarifMessage{Text: d.Message},
+			Locations: []sarifLocation{{
+				PhysicalLocation: physical,
+				LogicalLocations: []sarifLogicalLocation{{FullyQualifiedName: d.Location}},
+			}},
+		}
+		if d.Suppressed {
+			result.Suppressions = []sarifSuppression{{Kind: "external", Justification: d.Justification}}
+		}
+		results = append(results, result)
+	}
+	rules := []sarifRule{}
+	for id := range ruleIDs {
+		rules = append(rules, sarifRule{ID: id})
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+	return sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "aepc",
+				InformationURI: "https://github.com/aep-dev/aepc",
+				Rules:          rules,
+			}},
+			Results: results,
+		}},
+	}
+}
+
+func sarifLevel(s Severity) string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	default:
+		return "note"
+	}
+}
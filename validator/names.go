@@ -0,0 +1,178 @@
+package validator
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aepc/loader"
+)
+
+// reservedFields are generated for every resource, or used by
+// its standard methods, and cannot be declared by a resource or
+// by the schemas it is made of.
+var reservedFields = []string{"path", "id", "etag", "create_time"}
+
+var kebabCaseRegex = regexp.MustCompile("^[a-z][a-z0-9]*(-[a-z0-9]+)*$")
+
+// validateResourceNames returns the diagnostics found with the
+// key, singular and plural names of a resource.
+func validateResourceNames(loc, name string, r *api.Resource) []Diagnostic {
+	diags := []Diagnostic{}
+	for _, n := range []struct{ loc, name string }{
+		{loc, name},
+		{loc + Pointer("singular"), r.Singular},
+		{loc + Pointer("plural"), r.Plural},
+	} {
+		if !kebabCaseRegex.MatchString(n.name) {
+			diags = append(diags, newDiagnostic("resource-name-kebab-case", n.loc, fmt.Sprintf("%q must be lower kebab-case", n.name)))
+		}
+	}
+	if r.Plural == r.Singular {
+		diags = append(diags, newDiagnostic("resource-plural-distinct", loc+Pointer("plural"), fmt.Sprintf("plural %q must differ from the singular", r.Plural)))
+	} else if accepted := plurals(r.Singular); !slices.Contains(accepted, r.Plural) {
+		diags = append(diags, newDiagnostic("resource-plural-form", loc+Pointer("plural"), fmt.Sprintf("plural %q is not a plural of %q, e.g. %q", r.Plural, r.Singular, accepted[0])))
+	}
+	return diags
+}
+
+// irregularPlurals lists the plurals of words that do not
+// take a regular suffix, or that also take another one. The
+// list covers words common in resource names, and can be
+// extended as needed.
+var irregularPlurals = map[string][]string{
+	"analysis":  {"analyses"},
+	"appendix":  {"appendices", "appendixes"},
+	"axis":      {"axes"},
+	"child":     {"children"},
+	"criterion": {"criteria"},
+	"datum":     {"data"},
+	"foot":      {"feet"},
+	"goose":     {"geese"},
+	"index":     {"indexes", "indices"},
+	"man":       {"men"},
+	"matrix":    {"matrices", "matrixes"},
+	"medium":    {"media", "mediums"},
+	"mouse":     {"mice"},
+	"person":    {"people", "persons"},
+	"quiz":      {"quizzes"},
+	"schema":    {"schemas", "schemata"},
+	"tooth":     {"teeth"},
+	"vertex":    {"vertices", "vertexes"},
+	"woman":     {"women"},
+}
+
+// plurals returns the accepted plurals of a kebab-case name,
+// pluralizing its last word, starting with the regular plural
+// unless the word is irregular.
+func plurals(singular string) []string {
+	prefix, word := "", singular
+	if i := strings.LastIndex(singular, "-"); i >= 0 {
+		prefix, word = singular[:i+1], singular[i+1:]
+	}
+	words := irregularPlurals[word]
+	if len(words) == 0 {
+		words = []string{pluralize(word)}
+		switch {
+		case strings.HasSuffix(word, "sis"):
+			// e.g. diagnoses, theses
+			words = []string{strings.TrimSuffix(word, "is") + "es"}
+		case strings.HasSuffix(word, "fe"):
+			// e.g. lives, knives
+			words = append(words, strings.TrimSuffix(word, "fe")+"ves")
+		case strings.HasSuffix(word, "f"):
+			// e.g. shelves, leaves
+			words = append(words, strings.TrimSuffix(word, "f")+"ves")
+		case strings.HasSuffix(word, "o"):
+			// e.g. heroes, potatoes
+			words = append(words, word+"es")
+		}
+	}
+	accepted := []string{}
+	for _, w := range words {
+		accepted = append(accepted, prefix+w)
+	}
+	return accepted
+}
+
+// pluralize returns the regular English plural of a word.
+func pluralize(singular string) string {
+	switch {
+	case strings.HasSuffix(singular, "s"), strings.HasSuffix(singular, "x"),
+		strings.HasSuffix(singular, "z"), strings.HasSuffix(singular, "ch"),
+		strings.HasSuffix(singular, "sh"):
+		return singular + "es"
+	case strings.HasSuffix(singular, "y") && len(singular) > 1 && !strings.ContainsRune("aeiou", rune(singular[len(singular)-2])):
+		return strings.TrimSuffix(singular, "y") + "ies"
+	}
+	return singular + "s"
+}
+
+// verbs are the words accepted at the start of a custom
+// method name. The list covers the verbs used by the AEPs and
+// common APIs, and can be extended as needed.
+var verbs = map[string]bool{}
+
+func init() {
+	for _, v := range strings.Fields(`
+		add analyze apply approve archive assign attach batch cancel
+		check clear clone close commit compute copy count deploy
+		detach disable enable execute export fail fetch generate
+		grant import install invite lock merge move open pause
+		promote publish purge query rebuild redeploy refresh reject
+		release reload remove rename renew reorder replace report
+		reset resize restart restore resume retry revert revoke
+		rollback rotate run schedule search send set sign start stop
+		submit suspend sync test transfer translate trigger unarchive
+		undelete uninstall unlock unpublish unset update upgrade
+		upload validate verify watch
+	`) {
+		verbs[v] = true
+	}
+}
+
+// startsWithVerb reports whether the first word of a kebab,
+// snake or camel case name is a known verb.
+func startsWithVerb(name string) bool {
+	end := strings.IndexFunc(name, func(r rune) bool {
+		return r == '-' || r == '_' || unicode.IsUpper(r)
+	})
+	if end == 0 {
+		end = strings.IndexFunc(name[1:], unicode.IsUpper) + 1
+	}
+	if end <= 0 {
+		end = len(name)
+	}
+	return verbs[strings.ToLower(name[:end])]
+}
+
+// reservedFieldPattern matches the pointer of a property of a
+// resource, or of a shared schema, at any depth of nested
+// objects, capturing its name.
+var reservedFieldPattern = regexp.MustCompile(`^/(resources/[^/]+/schema|schemas/[^/]+)(/properties/[^/]+(/items)?)*/properties/([^/]+)$`)
+
+// validateReservedFields returns diagnostics for reserved
+// fields declared by a resource or a shared schema, including
+// their nested objects. As the path field is added to every
+// resource when loading, declarations are looked up in the
+// source of the definition.
+func validateReservedFields(d *loader.Definition) []Diagnostic {
+	diags := []Diagnostic{}
+	locs := []string{}
+	for loc := range d.Source {
+		m := reservedFieldPattern.FindStringSubmatch(loc)
+		if m != nil && slices.Contains(reservedFields, m[4]) {
+			locs = append(locs, loc)
+		}
+	}
+	sort.Strings(locs)
+	for _, loc := range locs {
+		name := reservedFieldPattern.FindStringSubmatch(loc)[4]
+		diags = append(diags, newDiagnostic("field-reserved-name", loc, fmt.Sprintf("field %q is reserved", name)))
+	}
+	return diags
+}
@@ -0,0 +1,67 @@
+package validator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+)
+
+// validateParents returns diagnostics for parents that are not
+// declared, and for resources that are their own ancestor.
+func validateParents(a *api.API) []Diagnostic {
+	diags := []Diagnostic{}
+	names := []string{}
+	for name := range a.Resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for i, p := range a.Resources[name].Parents {
+			if _, ok := a.Resources[p]; !ok {
+				diags = append(diags, newDiagnostic("resource-parent-unresolved", Pointer("resources", name, "parents", strconv.Itoa(i)), fmt.Sprintf("parent %q is not a declared resource", p)))
+			}
+		}
+	}
+
+	// a depth-first search from each resource, reporting each
+	// cycle once at the first resource of the cycle to be found.
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := map[string]int{}
+	var visit func(name string, stack []string)
+	visit = func(name string, stack []string) {
+		state[name] = visiting
+		stack = append(stack, name)
+		r := a.Resources[name]
+		parents := append([]string{}, r.Parents...)
+		sort.Strings(parents)
+		for _, p := range parents {
+			if _, ok := a.Resources[p]; !ok {
+				continue
+			}
+			switch state[p] {
+			case visiting:
+				i := 0
+				for stack[i] != p {
+					i++
+				}
+				cycle := append(append([]string{}, stack[i:]...), p)
+				diags = append(diags, newDiagnostic("resource-parent-cycle", Pointer("resources", p, "parents"), fmt.Sprintf("parents form a cycle: %s", strings.Join(cycle, " -> "))))
+			case 0:
+				visit(p, stack)
+			}
+		}
+		state[name] = visited
+	}
+	for _, name := range names {
+		if state[name] == 0 {
+			visit(name, nil)
+		}
+	}
+	return diags
+}
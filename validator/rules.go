@@ -0,0 +1,152 @@
+package validator
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Rule documents a check performed by the validator. Every
+// Diagnostic carries the ID of the rule that produced it.
+type Rule struct {
+	// ID identifies the rule, e.g. "resource-plural-form".
+	ID string `json:"id"`
+	// Severity is the severity of the rule's diagnostics.
+	Severity Severity `json:"severity"`
+	// Description explains what the rule checks.
+	Description string `json:"description"`
+	// Fixed rules cannot be disabled, have their severity
+	// changed or be suppressed, as the definitions they reject
+	// cannot be generated.
+	Fixed bool `json:"fixed,omitempty"`
+}
+
+var rules = map[string]Rule{}
+
+func init() {
+	for _, r := range []Rule{
+		{
+			ID:          "resource-singular-format",
+			Severity:    SeverityError,
+			Description: "The singular name of a resource must match " + RESOURCE_KIND_REGEX_STRING + ".",
+		},
+		{
+			ID:          "resource-name-kebab-case",
+			Severity:    SeverityError,
+			Description: "The key, singular and plural names of a resource must be lower kebab-case, e.g. book-edition.",
+		},
+		{
+			ID:          "resource-plural-distinct",
+			Severity:    SeverityError,
+			Description: "The plural name of a resource must differ from its singular name.",
+		},
+		{
+			ID:          "resource-plural-form",
+			Severity:    SeverityWarning,
+			Description: "The plural name of a resource should be a plural of its singular name, e.g. book-editions for book-edition or shelves for shelf.",
+		},
+		{
+			ID:          "resource-parent-unresolved",
+			Severity:    SeverityError,
+			Description: "Each parent of a resource must be a resource declared in the definition. It cannot be configured.",
+			Fixed:       true,
+		},
+		{
+			ID:          "resource-parent-cycle",
+			Severity:    SeverityError,
+			Description: "A resource must not be its own ancestor. It cannot be configured.",
+			Fixed:       true,
+		},
+		{
+			ID:          "field-reserved-name",
+			Severity:    SeverityError,
+			Description: "Resources, their nested objects and shared schemas must not declare the fields path, id, etag or create_time, which are reserved for the standard methods.",
+		},
+		{
+			ID:          "field-number-duplicate",
+			Severity:    SeverityError,
+			Description: "The field numbers of the properties of a message must be unique, including those of implicit fields such as path.",
+		},
+		{
+			ID:          "field-required-undeclared",
+			Severity:    SeverityError,
+			Description: "Each required field of a schema must be declared in its properties.",
+		},
+		{
+			ID:          "property-ref-and-properties",
+			Severity:    SeverityError,
+			Description: "A property must not declare both a $ref and properties.",
+		},
+		{
+			ID:          "custom-method-verb",
+			Severity:    SeverityWarning,
+			Description: "The name of a custom method should start with a verb, e.g. archive or publish.",
+		},
+		{
+			ID:          "schema-ref-unresolved",
+			Severity:    SeverityError,
+			Description: "A $ref must refer to a declared shared schema or resource.",
+		},
+		{
+			ID:          "schema-ref-cycle",
+			Severity:    SeverityError,
+			Description: "Shared schemas must not reference each other in a cycle.",
+		},
+		{
+			ID:          "enum-type",
+			Severity:    SeverityError,
+			Description: "An enum must be declared on a property of type string.",
+		},
+		{
+			ID:          "enum-empty",
+			Severity:    SeverityError,
+			Description: "An enum must declare at least one value.",
+		},
+		{
+			ID:          "enum-value-format",
+			Severity:    SeverityError,
+			Description: "Enum values must be UPPER_SNAKE_CASE.",
+		},
+		{
+			ID:          "enum-value-reserved",
+			Severity:    SeverityError,
+			Description: "Enum values must not end in _UNSPECIFIED, as the unspecified value is generated.",
+		},
+		{
+			ID:          "enum-value-duplicate",
+			Severity:    SeverityError,
+			Description: "The values of an enum must be unique.",
+		},
+		{
+			ID:          "enum-value-conflict",
+			Severity:    SeverityError,
+			Description: "Enums of sibling properties must not share values, as they are generated in the same proto scope.",
+		},
+	} {
+		rules[r.ID] = r
+	}
+}
+
+// Rules returns every rule of the validator, sorted by ID.
+func Rules() []Rule {
+	all := []Rule{}
+	for _, r := range rules {
+		all = append(all, r)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].ID < all[j].ID })
+	return all
+}
+
+// newDiagnostic returns a diagnostic of rule ruleID, with the
+// severity of the rule. It panics if the rule is not declared.
+func newDiagnostic(ruleID, loc, msg string) Diagnostic {
+	r, ok := rules[ruleID]
+	if !ok {
+		panic(fmt.Sprintf("undeclared rule %q", ruleID))
+	}
+	return Diagnostic{
+		RuleID:   r.ID,
+		Severity: r.Severity,
+		Location: loc,
+		Message:  msg,
+	}
+}
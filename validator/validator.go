@@ -5,9 +5,12 @@ package validator
 import (
 	"fmt"
 	"regexp"
+	"sort"
+	"strconv"
 
 	"github.com/aep-dev/aep-lib-go/pkg/api"
 	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+	"github.com/aep-dev/aepc/loader"
 )
 
 const (
@@ -22,45 +25,164 @@ func init() {
 	RESOURCE_KIND_REGEX = *regexp.MustCompile(RESOURCE_KIND_REGEX_STRING)
 }
 
-// ValidateAPI returns one or more errors
-// with a service.
-func ValidateAPI(a *api.API) []error {
-	errors := []error{}
-	for _, r := range a.Resources {
-		for _, err := range validateResource(r) {
-			errors = append(errors, fmt.Errorf("error validating resource %q: %w", r.Singular, err))
+// ValidateDefinition returns the diagnostics found with a
+// loaded definition, including those of ValidateAPI. A nil cfg
+// runs every rule with its default severity.
+func ValidateDefinition(d *loader.Definition, cfg *Config) []Diagnostic {
+	diags := validateAPI(d.API)
+	diags = append(diags, validateReservedFields(d)...)
+	diags = append(diags, validateEnums(d)...)
+	return cfg.apply(diags)
+}
+
+// ValidateAPI returns the diagnostics found
+// with a service. A nil cfg runs every rule with its default
+// severity.
+//
+// It only runs the rules that can be checked on an api.API,
+// which does not retain the enums or the declared properties
+// of the definition: field-reserved-name and the enum rules
+// are skipped. Use ValidateDefinition for a loaded definition.
+func ValidateAPI(a *api.API, cfg *Config) []Diagnostic {
+	return cfg.apply(validateAPI(a))
+}
+
+func validateAPI(a *api.API) []Diagnostic {
+	diags := []Diagnostic{}
+	names := []string{}
+	for name := range a.Resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		diags = append(diags, validateResource(Pointer("resources", name), name, a.Resources[name])...)
+	}
+	schemaNames := []string{}
+	for name := range a.Schemas {
+		schemaNames = append(schemaNames, name)
+	}
+	sort.Strings(schemaNames)
+	for _, name := range schemaNames {
+		if s := a.Schemas[name]; s != nil {
+			diags = append(diags, validateSchema(Pointer("schemas", name), s)...)
 		}
 	}
-	return errors
+	diags = append(diags, validateParents(a)...)
+	diags = append(diags, validateSchemaRefs(a)...)
+	return diags
 }
 
-// validateResource returns any validation errors
+// validateSchemaRefs returns diagnostics for references to
+// shared schemas that do not exist, or that form a cycle.
+func validateSchemaRefs(a *api.API) []Diagnostic {
+	diags := []Diagnostic{}
+	schemas := loader.NamedSchemas(a)
+	names := []string{}
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		loc := Pointer("schemas", name)
+		if r, ok := a.Resources[name]; ok && r.Schema == schemas[name] {
+			loc = Pointer("resources", name, "schema")
+		}
+		for _, ref := range loader.SchemaRefs(schemas[name]) {
+			if _, ok := schemas[ref.Name]; !ok {
+				diags = append(diags, newDiagnostic("schema-ref-unresolved", loc+ref.Pointer, fmt.Sprintf("reference to undeclared schema %q", ref.Name)))
+			}
+		}
+	}
+	if _, err := loader.SchemaOrder(a); err != nil {
+		if cycleErr, ok := err.(*loader.RefCycleError); ok {
+			diags = append(diags, newDiagnostic("schema-ref-cycle", Pointer("schemas", cycleErr.Cycle[0]), cycleErr.Error()))
+		}
+	}
+	return diags
+}
+
+// validateResource returns any diagnostics
 // with a resource.
-func validateResource(r *api.Resource) []error {
+func validateResource(loc, name string, r *api.Resource) []Diagnostic {
 	regex := regexp.MustCompile(RESOURCE_KIND_REGEX_STRING)
-	errors := []error{}
+	diags := []Diagnostic{}
 	if !regex.MatchString(r.Singular) {
-		errors = append(
-			errors,
-			fmt.Errorf("kind must match regex %q", RESOURCE_KIND_REGEX_STRING),
-		)
+		diags = append(diags, newDiagnostic("resource-singular-format", loc+Pointer("singular"), fmt.Sprintf("kind must match regex %q", RESOURCE_KIND_REGEX_STRING)))
 	}
+	diags = append(diags, validateResourceNames(loc, name, r)...)
 
-	for _, p := range r.Schema.Properties {
-		errors = append(errors, validateProperty(&p)...)
+	if r.Schema != nil {
+		diags = append(diags, validateSchema(loc+Pointer("schema"), r.Schema)...)
 	}
-	return errors
+	for i, cm := range r.CustomMethods {
+		cmLoc := loc + Pointer("custom_methods", strconv.Itoa(i))
+		if !startsWithVerb(cm.Name) {
+			diags = append(diags, newDiagnostic("custom-method-verb", cmLoc+Pointer("name"), fmt.Sprintf("custom method %q should start with a verb", cm.Name)))
+		}
+		if cm.Request != nil {
+			diags = append(diags, validateSchema(cmLoc+Pointer("request"), cm.Request)...)
+		}
+		if cm.Response != nil {
+			diags = append(diags, validateSchema(cmLoc+Pointer("response"), cm.Response)...)
+		}
+	}
+	return diags
+}
+
+// validateSchema returns the diagnostics found with a schema
+// that generates a message, and with its properties.
+func validateSchema(loc string, s *openapi.Schema) []Diagnostic {
+	diags := []Diagnostic{}
+	for i, name := range s.Required {
+		if _, ok := s.Properties[name]; !ok {
+			diags = append(diags, newDiagnostic("field-required-undeclared", loc+Pointer("required", strconv.Itoa(i)), fmt.Sprintf("required field %q is not declared in properties", name)))
+		}
+	}
+	names := []string{}
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	numbers := map[int]string{}
+	for _, name := range names {
+		f := s.Properties[name].XAEPField
+		if f == nil || f.FieldNumber == 0 {
+			continue
+		}
+		if other, ok := numbers[f.FieldNumber]; ok {
+			diags = append(diags, newDiagnostic("field-number-duplicate", loc+Pointer("properties", name, "x-aep-field", "field_number"), fmt.Sprintf("field number %d is also used by field %q", f.FieldNumber, other)))
+			continue
+		}
+		numbers[f.FieldNumber] = name
+	}
+	diags = append(diags, validateProperties(loc, s.Properties)...)
+	return diags
+}
+
+func validateProperties(loc string, properties openapi.Properties) []Diagnostic {
+	diags := []Diagnostic{}
+	names := []string{}
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		p := properties[name]
+		diags = append(diags, validateProperty(loc+Pointer("properties", name), &p)...)
+	}
+	return diags
 }
 
-func validateProperty(p *openapi.Schema) []error {
-	errors := []error{}
+func validateProperty(loc string, p *openapi.Schema) []Diagnostic {
+	diags := []Diagnostic{}
 	if p.Ref != "" && p.Properties != nil {
-		errors = append(errors, fmt.Errorf("cannot set both ref and properties on %v", p))
+		diags = append(diags, newDiagnostic("property-ref-and-properties", loc, "cannot set both ref and properties"))
 	}
 	if p.Properties != nil {
-		for _, p := range p.Properties {
-			errors = append(errors, validateProperty(&p)...)
-		}
+		diags = append(diags, validateSchema(loc, p)...)
+	}
+	if p.Items != nil {
+		diags = append(diags, validateProperty(loc+Pointer("items"), p.Items)...)
 	}
-	return errors
+	return diags
 }
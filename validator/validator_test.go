@@ -0,0 +1,198 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aep-dev/aepc/loader"
+)
+
+func TestValidateDefinition(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		want     []string
+		wantLocs []string
+	}{
+		{
+			name: "valid",
+			input: `
+resources:
+  book-edition:
+    singular: book-edition
+    plural: book-editions
+    parents: ["publisher"]
+    schema:
+      required: ["title"]
+      properties:
+        title: {type: string, x-aep-field: {field_number: 1}}
+    custom_methods:
+      - {name: "publish", method: "POST"}
+  publisher:
+    singular: publisher
+    plural: publishers
+    schema: {}
+  shelf:
+    singular: shelf
+    plural: shelves
+    schema: {}
+  book-child:
+    singular: book-child
+    plural: book-children
+    schema: {}
+`,
+		},
+		{
+			name: "names",
+			input: `
+resources:
+  book_edition:
+    singular: book_edition
+    plural: book_edition
+    schema: {}
+  child:
+    singular: child
+    plural: childs
+    schema: {}
+`,
+			want:     []string{"resource-name-kebab-case", "resource-name-kebab-case", "resource-name-kebab-case", "resource-plural-distinct", "resource-plural-form"},
+			wantLocs: []string{"/resources/book_edition", "/resources/book_edition/singular", "/resources/book_edition/plural", "/resources/book_edition/plural", "/resources/child/plural"},
+		},
+		{
+			name: "parents",
+			input: `
+resources:
+  author:
+    singular: author
+    plural: authors
+    parents: ["book", "missing"]
+    schema: {}
+  book:
+    singular: book
+    plural: books
+    parents: ["author"]
+    schema: {}
+`,
+			want:     []string{"resource-parent-unresolved", "resource-parent-cycle"},
+			wantLocs: []string{"/resources/author/parents/1", "/resources/author/parents"},
+		},
+		{
+			name: "fields",
+			input: `
+resources:
+  book:
+    singular: book
+    plural: books
+    schema:
+      required: ["title", "author"]
+      properties:
+        etag: {type: string, x-aep-field: {field_number: 1}}
+        title: {type: string, x-aep-field: {field_number: 1}}
+        isbn: {type: string, x-aep-field: {field_number: 10018}}
+`,
+			want:     []string{"field-required-undeclared", "field-number-duplicate", "field-number-duplicate", "field-reserved-name"},
+			wantLocs: []string{"/resources/book/schema/required/1", "/resources/book/schema/properties/path/x-aep-field/field_number", "/resources/book/schema/properties/title/x-aep-field/field_number", "/resources/book/schema/properties/etag"},
+		},
+		{
+			name: "reserved fields of nested objects and shared schemas",
+			input: `
+schemas:
+  author:
+    type: object
+    properties:
+      id: {type: string, x-aep-field: {field_number: 1}}
+resources:
+  book:
+    singular: book
+    plural: books
+    schema:
+      properties:
+        metadata:
+          type: object
+          x-aep-field: {field_number: 1}
+          properties:
+            create_time: {type: string, x-aep-field: {field_number: 1}}
+        editions:
+          type: array
+          x-aep-field: {field_number: 2}
+          items:
+            type: object
+            properties:
+              etag: {type: string, x-aep-field: {field_number: 1}}
+`,
+			want: []string{"field-reserved-name", "field-reserved-name", "field-reserved-name"},
+			wantLocs: []string{
+				"/resources/book/schema/properties/editions/items/properties/etag",
+				"/resources/book/schema/properties/metadata/properties/create_time",
+				"/schemas/author/properties/id",
+			},
+		},
+		{
+			name: "custom method",
+			input: `
+resources:
+  book:
+    singular: book
+    plural: books
+    schema: {}
+    custom_methods:
+      - {name: "publishBook", method: "POST"}
+      - {name: "summary", method: "GET"}
+`,
+			want:     []string{"custom-method-verb"},
+			wantLocs: []string{"/resources/book/custom_methods/1/name"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := loader.Load("f.yaml", []byte("name: x"+tt.input), loader.Options{})
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			got, gotLocs := []string{}, []string{}
+			for _, diag := range ValidateDefinition(d, nil) {
+				got = append(got, diag.RuleID)
+				gotLocs = append(gotLocs, diag.Location)
+			}
+			if tt.want == nil {
+				tt.want, tt.wantLocs = []string{}, []string{}
+			}
+			if !reflect.DeepEqual(got, tt.want) || !reflect.DeepEqual(gotLocs, tt.wantLocs) {
+				t.Errorf("ValidateDefinition() = %v at %v, want %v at %v", got, gotLocs, tt.want, tt.wantLocs)
+			}
+		})
+	}
+}
+
+func TestRulesAreDocumented(t *testing.T) {
+	for _, r := range Rules() {
+		if r.Severity == "" || r.Description == "" {
+			t.Errorf("rule %q is missing a severity or description", r.ID)
+		}
+	}
+}
+
+func TestLoadDiagnostics(t *testing.T) {
+	_, err := loader.Load("f.yaml", []byte("name: x\nresources:\n  book:\n    singular: 1\n"), loader.Options{})
+	diags, ok := LoadDiagnostics(err)
+	if !ok || len(diags) == 0 {
+		t.Fatalf("LoadDiagnostics(%v) = %v, %v, want diagnostics", err, diags, ok)
+	}
+	want := Diagnostic{
+		RuleID:   "load-wrong-type",
+		Severity: SeverityError,
+		Location: "/resources/book/singular",
+		File:     "f.yaml",
+		Line:     4,
+		Column:   5,
+	}
+	got := diags[0]
+	got.Message = ""
+	if got != want {
+		t.Errorf("LoadDiagnostics() = %+v, want %+v", got, want)
+	}
+	if _, ok := LoadDiagnostics(fmt.Errorf("unable to read file")); ok {
+		t.Errorf("LoadDiagnostics() of another error = true, want false")
+	}
+}